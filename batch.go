@@ -0,0 +1,154 @@
+package organisationsnummer
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// batchChunk is the number of inputs a worker takes at a time.
+const batchChunk = 256
+
+// BatchOptions represents the options for ParseBatch.
+type BatchOptions struct {
+	// Workers is the number of goroutines used, GOMAXPROCS by default.
+	Workers int
+	// Options is used when parsing each input.
+	Options *Options
+}
+
+// Result is the result of parsing a single input in a batch.
+type Result struct {
+	// Index is the index of the input.
+	Index int
+	// Input is the parsed input.
+	Input string
+	// Number is the organization number, nil if Err is set.
+	Number *Organisationsnummer
+	// Err is the parse error, or the context error if the input was
+	// never parsed.
+	Err error
+}
+
+// ItemError is the error of a single input in a batch.
+type ItemError struct {
+	// Index is the index of the input.
+	Index int
+	// Err is the parse error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("input %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the parse error.
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError contains the errors of all inputs that failed in a batch,
+// ordered by index.
+type BatchError struct {
+	Errors []*ItemError
+}
+
+// Error implements the error interface, the messages are separated by
+// newlines like errors.Join.
+func (e *BatchError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the item errors.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// ParseBatch parse Swedish organization numbers concurrently and returns
+// the results in the same order as the inputs. The error is a *BatchError
+// when any input is invalid, or the context error when the context is
+// done before all inputs are parsed.
+func ParseBatch(ctx context.Context, inputs []string, options ...*BatchOptions) ([]Result, error) {
+	opts := &BatchOptions{}
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	results := make([]Result, len(inputs))
+	for i, input := range inputs {
+		results[i] = Result{Index: i, Input: input}
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	done := ctx.Done()
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				start := int(next.Add(batchChunk)) - batchChunk
+				if start >= len(inputs) {
+					return
+				}
+
+				for i := start; i < min(start+batchChunk, len(inputs)); i++ {
+					select {
+					case <-done:
+						return
+					default:
+					}
+
+					results[i].Number, results[i].Err = Parse(inputs[i], opts.Options)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if results[i].Number == nil && results[i].Err == nil {
+				results[i].Err = err
+			}
+		}
+
+		return results, err
+	}
+
+	var errs []*ItemError
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, &ItemError{Index: r.Index, Err: r.Err})
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, &BatchError{Errors: errs}
+	}
+
+	return results, nil
+}
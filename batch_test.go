@@ -0,0 +1,76 @@
+package organisationsnummer
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestParseBatch(t *testing.T) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+
+	inputs := make([]string, 10000)
+	for i := range inputs {
+		inputs[i] = g.Next().Format(true)
+	}
+	inputs[17] = "556016-0681"
+	inputs[9001] = "foo"
+
+	results, err := ParseBatch(context.Background(), inputs, &BatchOptions{Workers: 4})
+	assert.Equal(t, len(inputs), len(results))
+
+	for i, r := range results {
+		assert.Equal(t, i, r.Index)
+		assert.Equal(t, inputs[i], r.Input)
+
+		if i == 17 || i == 9001 {
+			assert.NotNil(t, r.Err)
+			continue
+		}
+
+		assert.Nil(t, r.Err)
+		assert.Equal(t, inputs[i], r.Number.Format(true))
+	}
+
+	var berr *BatchError
+	assert.True(t, errors.As(err, &berr))
+	assert.Equal(t, 2, len(berr.Errors))
+	assert.Equal(t, 17, berr.Errors[0].Index)
+	assert.Equal(t, 9001, berr.Errors[1].Index)
+	assert.True(t, errors.Is(err, ErrInvalidOrganizationNumber))
+	assert.Equal(t, "input 17: Invalid Swedish organization number: invalid check digit at offset 10\ninput 9001: Invalid Swedish organization number: invalid length", err.Error())
+
+	var perr *ParseError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, ReasonChecksum, perr.Reason)
+}
+
+func TestParseBatchOptions(t *testing.T) {
+	results, err := ParseBatch(context.Background(), []string{"556016-0680", "121212-1212"}, &BatchOptions{
+		Options: &Options{DisablePersonnummer: true},
+	})
+
+	var berr *BatchError
+	assert.True(t, errors.As(err, &berr))
+	assert.Equal(t, 1, berr.Errors[0].Index)
+	assert.NotNil(t, results[0].Number)
+
+	results, err = ParseBatch(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(results))
+}
+
+func TestParseBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := ParseBatch(ctx, []string{"556016-0680", "202100-5489"})
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	for _, r := range results {
+		assert.True(t, errors.Is(r.Err, context.Canceled))
+	}
+}
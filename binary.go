@@ -0,0 +1,131 @@
+package organisationsnummer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// binarySoleTrader is set for sole traders, the number is then the
+	// twelve digit personnummer including the century.
+	binarySoleTrader = 1 << 63
+	// binaryDigits is the mask of the decimal number.
+	binaryDigits = 1<<40 - 1
+	// binaryInterimShift is the shift of the interim letter, stored as
+	// its index plus one since it replaces a digit of the number.
+	binaryInterimShift = 40
+	binaryInterimMask  = 0xf << binaryInterimShift
+	// binarySize is the size of the binary encoding.
+	binarySize = 8
+)
+
+// interimLetters are the letters personnummer interim numbers may contain.
+const interimLetters = "TRSUWXJKLMN"
+
+// Uint64 returns the organization number as a integer, sole traders are
+// distinguished by the highest bit and keeps the century. The zero value
+// returns 0.
+func (o *Organisationsnummer) Uint64() uint64 {
+	if o.IsZero() {
+		return 0
+	}
+
+	if !o.IsPersonnummer() {
+		v, _ := strconv.ParseUint(o.number, 10, 64)
+		return v
+	}
+
+	number := []byte(o.personnummer.Century + o.number)
+	v := uint64(binarySoleTrader)
+
+	if i := strings.IndexByte(interimLetters, number[8]); i >= 0 {
+		v |= uint64(i+1) << binaryInterimShift
+		number[8] = '0'
+	}
+
+	n, _ := strconv.ParseUint(string(number), 10, 64)
+
+	return v | n
+}
+
+// FromUint64 returns the organization number of a integer created by Uint64.
+func FromUint64(v uint64) (*Organisationsnummer, error) {
+	input := strconv.FormatUint(v, 10)
+
+	if v&binarySoleTrader == 0 {
+		if v > 9999999999 {
+			return nil, newParseError(input, ReasonEncoding, -1)
+		}
+
+		o, err := New(fmt.Sprintf("%010d", v))
+		if err != nil {
+			return nil, err
+		}
+
+		if o.IsPersonnummer() {
+			return nil, newParseError(input, ReasonEncoding, -1)
+		}
+
+		return o, nil
+	}
+
+	if v&^(binarySoleTrader|binaryDigits|binaryInterimMask) != 0 || v&binaryDigits > 999999999999 {
+		return nil, newParseError(input, ReasonEncoding, -1)
+	}
+
+	number := []byte(strconv.FormatUint(v&binaryDigits, 10))
+	if len(number) != 12 {
+		return nil, newParseError(input, ReasonEncoding, -1)
+	}
+
+	if i := (v & binaryInterimMask) >> binaryInterimShift; i > 0 {
+		if int(i) > len(interimLetters) || number[8] != '0' {
+			return nil, newParseError(input, ReasonEncoding, -1)
+		}
+
+		number[8] = interimLetters[i-1]
+	}
+
+	o, err := New(string(number), &Options{AllowInterimNumber: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.IsPersonnummer() {
+		return nil, newParseError(input, ReasonEncoding, -1)
+	}
+
+	return o, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, the number is encoded
+// as the big endian eight bytes of Uint64 so the encoding sorts like Uint64.
+func (o Organisationsnummer) MarshalBinary() ([]byte, error) {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, binarySize), o.Uint64()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, zero decodes to
+// the zero value. The TextFormat of the receiver is kept.
+func (o *Organisationsnummer) UnmarshalBinary(data []byte) error {
+	if len(data) != binarySize {
+		return newParseError(fmt.Sprintf("%x", data), ReasonEncoding, -1)
+	}
+
+	v := binary.BigEndian.Uint64(data)
+	if v == 0 {
+		*o = Organisationsnummer{format: o.format}
+		return nil
+	}
+
+	n, err := FromUint64(v)
+	if err != nil {
+		return err
+	}
+
+	n.format = o.format
+	*o = *n
+
+	return nil
+}
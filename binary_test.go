@@ -0,0 +1,89 @@
+package organisationsnummer
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected uint64
+	}{
+		{"556016-0680", 5560160680},
+		{"165560160680", 5560160680},
+		{"19121212-1212", 1<<63 | 191212121212},
+		{"121212+1212", 1<<63 | 191212121212},
+		{"121212-1212", 1<<63 | 201212121212},
+	}
+
+	for _, test := range tests {
+		o, _ := Parse(test.input)
+		assert.Equal(t, test.expected, o.Uint64(), test.input)
+
+		n, err := FromUint64(o.Uint64())
+		assert.Nil(t, err, test.input)
+		assert.Equal(t, o.FormatAs(FormatLong), n.FormatAs(FormatLong), test.input)
+		assert.Equal(t, o.Format(true), n.Format(true), test.input)
+	}
+}
+
+func TestUint64RoundTrip(t *testing.T) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+	g.SoleTraders = true
+
+	for i := 0; i < 10000; i++ {
+		o := g.Next()
+
+		n, err := FromUint64(o.Uint64())
+		assert.Nil(t, err)
+		assert.Equal(t, o.FormatAs(FormatLong), n.FormatAs(FormatLong))
+		assert.Equal(t, o.IsPersonnummer(), n.IsPersonnummer())
+	}
+}
+
+func TestUint64Interim(t *testing.T) {
+	options := &Options{AllowInterimNumber: true}
+
+	for _, input := range []string{"000101-T220", "701063-2391"} {
+		o, err := Parse(input, options)
+		assert.Nil(t, err, input)
+
+		n, err := FromUint64(o.Uint64())
+		assert.Nil(t, err, input)
+		assert.Equal(t, o.FormatAs(FormatLong), n.FormatAs(FormatLong), input)
+	}
+}
+
+func TestFromUint64Invalid(t *testing.T) {
+	for _, v := range []uint64{
+		0,
+		5560160681,
+		10000000000,
+		1212121212,
+		1<<63 | 5560160680,
+		1<<63 | 1<<50 | 191212121212,
+		1<<63 | 12<<40 | 191212120212,
+		1<<63 | 1<<40 | 191212121212,
+	} {
+		_, err := FromUint64(v)
+		assert.NotNil(t, err, v)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	o, _ := Parse("19121212-1212", &Options{TextFormat: FormatLong})
+
+	data, err := o.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(data))
+
+	n := Organisationsnummer{format: FormatHyphen}
+	assert.Nil(t, n.UnmarshalBinary(data))
+	assert.Equal(t, "121212+1212", n.FormatAs(n.format))
+	assert.Equal(t, o.Uint64(), n.Uint64())
+
+	assertReason(t, n.UnmarshalBinary(data[:7]), ReasonEncoding)
+}
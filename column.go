@@ -0,0 +1,224 @@
+package organisationsnummer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	swarInvalid = iota
+	swarValid
+	// swarSlow is used for fields that are not ten digits of a organization
+	// number, like personnummer or fields with separators.
+	swarSlow
+)
+
+const (
+	swarOnes   = 0x0101010101010101
+	swarZeros  = 0x3030303030303030
+	swarNibble = 0xf0f0f0f0f0f0f0f0
+	// swarLanes selects the low byte of each 16-bit lane.
+	swarLanes = 0x00ff00ff00ff00ff
+)
+
+// nonZero returns 1 when x is not zero and 0 otherwise, without branching.
+func nonZero(x uint64) uint64 {
+	return (x | -x) >> 63
+}
+
+// notDigits returns a non zero value unless the bytes of w selected by mask
+// are all ASCII digits, adding 6 moves 0x3a-0x3f out of the 0x30 range.
+func notDigits(w, mask uint64) uint64 {
+	return ((w&swarNibble ^ swarZeros) | ((w+0x0606060606060606)&swarNibble ^ swarZeros)) & mask
+}
+
+// swarLoad loads the first ten bytes of a field as digit values, the first
+// eight in lo and the last two in hi. slow is 1 when the field is not ten
+// digits or the third digit is below 2 so it may be a personnummer, lo and
+// hi are then 0 so they can be summed together with other fields.
+func swarLoad(field []byte) (lo, hi, slow uint64) {
+	lo = binary.LittleEndian.Uint64(field)
+	hi = uint64(binary.LittleEndian.Uint16(field[8:10]))
+
+	slow = nonZero(notDigits(lo, ^uint64(0)) | notDigits(hi, 0xffff))
+
+	lo -= swarZeros
+	hi -= 0x3030
+
+	// The subtraction wraps for a third digit below 2.
+	slow |= ((lo>>16)&0xff - 2) >> 63
+
+	lo &= slow - 1
+	hi &= slow - 1
+
+	return lo, hi, slow
+}
+
+// double returns the luhn value of a doubled digit, subtracting 9 for
+// digits 5 or more.
+func double(d uint64) uint64 {
+	return d<<1 - ((d+3)>>3)*9
+}
+
+// swarPair validates the digits of two fields loaded by swarLoad and
+// returns 1 for each valid field. The first eight digits of both fields
+// are summed in one word, a in the low and b in the high byte of each
+// 16-bit lane, the lanes sums are at most 18 so no byte overflows.
+func swarPair(loA, hiA, loB, hiB uint64) (uint64, uint64) {
+	even := loA&swarLanes | (loB&swarLanes)<<8
+	odd := (loA>>8)&swarLanes | loB&^swarLanes
+
+	// The even digits are doubled, subtracting 9 for digits 5 or more.
+	over := ((even + 0x0303030303030303) >> 3) & swarOnes
+	sum := even<<1 - over*9 + odd
+
+	// Add the four 16-bit lanes into the top lane, at most 72 per byte.
+	total := (sum * 0x0001000100010001) >> 48
+
+	a := total&0xff + double(hiA&0xff) + hiA>>8
+	b := total>>8 + double(hiB&0xff) + hiB>>8
+
+	// May not start with leading 0.
+	validA := nonZero(loA&0xff) &^ nonZero(a%10)
+	validB := nonZero(loB&0xff) &^ nonZero(b%10)
+
+	return validA, validB
+}
+
+// swar validates a ten digit organization number using SWAR, processing
+// the first eight digits in a single 64-bit word.
+func swar(field []byte) int {
+	lo, hi, slow := swarLoad(field)
+	if slow != 0 {
+		return swarSlow
+	}
+
+	if valid, _ := swarPair(lo, hi, 0, 0); valid != 0 {
+		return swarValid
+	}
+
+	return swarInvalid
+}
+
+// blank returns 1 when field only contains spaces.
+func blank(field []byte) uint64 {
+	for _, c := range field {
+		if c != ' ' {
+			return 0
+		}
+	}
+
+	return 1
+}
+
+// swar4 validates the fields of four records starting at the byte offset
+// at using SWAR, two fields are summed per word. A bit is set in valid for
+// each valid field and in slow for each field that must be validated with
+// validField. Fields wider than ten bytes must be padded with spaces.
+func swar4(data []byte, at, stride, width int) (valid, slow uint64) {
+	field0 := data[at : at+width]
+	field1 := data[at+stride : at+stride+width]
+	field2 := data[at+2*stride : at+2*stride+width]
+	field3 := data[at+3*stride : at+3*stride+width]
+
+	lo0, hi0, slow0 := swarLoad(field0)
+	lo1, hi1, slow1 := swarLoad(field1)
+	lo2, hi2, slow2 := swarLoad(field2)
+	lo3, hi3, slow3 := swarLoad(field3)
+
+	if width > 10 {
+		slow0 |= 1 ^ blank(field0[10:])
+		slow1 |= 1 ^ blank(field1[10:])
+		slow2 |= 1 ^ blank(field2[10:])
+		slow3 |= 1 ^ blank(field3[10:])
+	}
+
+	valid0, valid1 := swarPair(lo0, hi0, lo1, hi1)
+	valid2, valid3 := swarPair(lo2, hi2, lo3, hi3)
+
+	slow = slow0 | slow1<<1 | slow2<<2 | slow3<<3
+	valid = (valid0 | valid1<<1 | valid2<<2 | valid3<<3) &^ slow
+
+	return valid, slow
+}
+
+// validField validates a field of a column, falling back to ValidBytes for
+// fields that are not plain ten digit organization numbers.
+func validField(field []byte) uint64 {
+	if len(field) != 10 {
+		field = bytes.TrimSpace(field)
+	}
+
+	if len(field) == 10 {
+		switch swar(field) {
+		case swarValid:
+			return 1
+		case swarInvalid:
+			return 0
+		}
+	}
+
+	if ValidBytes(field) {
+		return 1
+	}
+
+	return 0
+}
+
+// ValidColumn validates a column of fixed width records, record i is
+// data[i*stride:(i+1)*stride] and the number is the width bytes at offset,
+// surrounding spaces are ignored. Bit i%64 of bitmap[i/64] is set when record
+// i is valid, and the number of valid records is returned.
+//
+// Ten digit organization numbers, optionally followed by spaces, are
+// validated four records per iteration without branching, the luhn sums of
+// two records are computed together in a single 64-bit word. Other values,
+// like personnummer or numbers with separators, are validated with
+// ValidBytes. ValidColumn panics if the layout is invalid or the bitmap is
+// too small.
+func ValidColumn(bitmap []uint64, data []byte, stride, offset, width int) int {
+	if stride <= 0 || offset < 0 || width <= 0 || offset+width > stride {
+		panic("organisationsnummer: invalid column layout")
+	}
+
+	records := len(data) / stride
+	if len(bitmap)*64 < records {
+		panic("organisationsnummer: bitmap too small")
+	}
+
+	valid := 0
+
+	for w := 0; w*64 < records; w++ {
+		start := w * 64
+		end := min(start+64, records)
+
+		var word uint64
+
+		i := start
+		for ; width >= 10 && i+4 <= end; i += 4 {
+			at := i*stride + offset
+			v, slow := swar4(data, at, stride, width)
+
+			for slow != 0 {
+				k := bits.TrailingZeros64(slow)
+				slow &= slow - 1
+
+				field := at + k*stride
+				v |= validField(data[field:field+width]) << k
+			}
+
+			word |= v << (i - start)
+		}
+
+		for ; i < end; i++ {
+			at := i*stride + offset
+			word |= validField(data[at:at+width]) << (i - start)
+		}
+
+		bitmap[w] = word
+		valid += bits.OnesCount64(word)
+	}
+
+	return valid
+}
@@ -0,0 +1,207 @@
+package organisationsnummer
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestSwar(t *testing.T) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for i := 0; i < 100000; i++ {
+		field := []byte(g.Next().Format(false))
+		switch i % 3 {
+		case 1:
+			field[r.IntN(10)] = byte('0' + r.IntN(10))
+		case 2:
+			field[r.IntN(10)] = byte(r.IntN(256))
+		}
+
+		switch swar(field) {
+		case swarValid:
+			assert.True(t, Valid(string(field)), string(field))
+		case swarInvalid:
+			assert.False(t, Valid(string(field)), string(field))
+		}
+	}
+}
+
+// byteVariants returns number with every byte value at every position.
+func byteVariants(number string) []string {
+	var variants []string
+
+	for i := 0; i < len(number); i++ {
+		for c := 0; c < 256; c++ {
+			field := []byte(number)
+			field[i] = byte(c)
+			variants = append(variants, string(field))
+		}
+	}
+
+	return variants
+}
+
+func TestSwarBytes(t *testing.T) {
+	for _, field := range byteVariants("5560160680") {
+		switch swar([]byte(field)) {
+		case swarValid:
+			assert.True(t, ValidBytes([]byte(field)), field)
+		case swarInvalid:
+			assert.False(t, ValidBytes([]byte(field)), field)
+		}
+
+		lo, hi, slow := swarLoad([]byte(field))
+		if slow == 0 {
+			valid, _ := swarPair(lo, hi, 0, 0)
+			assert.Equal(t, ValidBytes([]byte(field)), valid == 1, field)
+		}
+	}
+
+	for _, field := range []string{"5-60160680", "$560160680", "\x01560160680", "+560160680"} {
+		assert.Equal(t, swarSlow, swar([]byte(field)), field)
+	}
+}
+
+func TestSwarPair(t *testing.T) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for i := 0; i < 100000; i++ {
+		a := []byte(g.Next().Format(false))
+		b := []byte(g.Next().Format(false))
+		a[r.IntN(10)] = byte('0' + r.IntN(10))
+		b[r.IntN(10)] = byte(r.IntN(256))
+		if i%2 == 1 {
+			a, b = b, a
+		}
+
+		loA, hiA, slowA := swarLoad(a)
+		loB, hiB, slowB := swarLoad(b)
+		validA, validB := swarPair(loA, hiA, loB, hiB)
+
+		if slowA == 0 {
+			assert.Equal(t, Valid(string(a)), validA == 1, string(a))
+		}
+		if slowB == 0 {
+			assert.Equal(t, Valid(string(b)), validB == 1, string(b))
+		}
+	}
+}
+
+func TestValidColumn(t *testing.T) {
+	inputs := []string{
+		"5560160680",
+		"5560160681",
+		"0560160680",
+		"556016-0680",
+		"121212-1212",
+		"1212121212",
+		" 202100-5489",
+		"foo",
+		"",
+		"5560１60680",
+	}
+
+	g := NewGenerator(rand.NewPCG(1, 2))
+	g.SoleTraders = true
+	for i := 0; i < 200; i++ {
+		number := []byte(g.Next().Format(false))
+		if i%3 == 0 {
+			number[9] = (number[9]-'0'+1)%10 + '0'
+		}
+		inputs = append(inputs, string(number))
+	}
+
+	inputs = append(inputs, byteVariants("5560160680")...)
+
+	for _, width := range []int{8, 10, 13} {
+		var data bytes.Buffer
+		expected := 0
+
+		for _, input := range inputs {
+			field := fmt.Sprintf("%-*s", width, input)[:width]
+			fmt.Fprintf(&data, "%03d;%s;\n", len(input), field)
+
+			if Valid(string(bytes.TrimSpace([]byte(field)))) {
+				expected++
+			}
+		}
+
+		bitmap := make([]uint64, (len(inputs)+63)/64)
+		n := ValidColumn(bitmap, data.Bytes(), width+6, 4, width)
+		assert.Equal(t, expected, n)
+
+		for i, input := range inputs {
+			field := fmt.Sprintf("%-*s", width, input)[:width]
+			valid := bitmap[i/64]&(1<<(i%64)) != 0
+			assert.Equal(t, Valid(string(bytes.TrimSpace([]byte(field)))), valid, input)
+		}
+	}
+}
+
+func TestValidColumnPanics(t *testing.T) {
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+
+	ValidColumn(make([]uint64, 1), make([]byte, 65*10), 10, 0, 10)
+}
+
+func BenchmarkValidColumn(b *testing.B) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+
+	var data []byte
+	for i := 0; i < 1024; i++ {
+		data = g.Next().AppendFormat(data, FormatShort)
+	}
+
+	bitmap := make([]uint64, 1024/64)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		ValidColumn(bitmap, data, 10, 0, 10)
+	}
+}
+
+func BenchmarkValidFieldColumn(b *testing.B) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+
+	var data []byte
+	for i := 0; i < 1024; i++ {
+		data = g.Next().AppendFormat(data, FormatShort)
+	}
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < len(data); j += 10 {
+			validField(data[j : j+10])
+		}
+	}
+}
+
+func BenchmarkValidBytesColumn(b *testing.B) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+
+	var data []byte
+	for i := 0; i < 1024; i++ {
+		data = g.Next().AppendFormat(data, FormatShort)
+	}
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < len(data); j += 10 {
+			ValidBytes(data[j : j+10])
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package organisationsnummer
+
+import (
+	"cmp"
+)
+
+// Key is a comparable representation of a organization number which can
+// be used as map key, equal numbers has equal keys regardless of format.
+type Key uint64
+
+// Key returns the comparable key of the organization number.
+func (o *Organisationsnummer) Key() Key {
+	return Key(o.Uint64())
+}
+
+// Organisationsnummer returns the organization number of the key.
+func (k Key) Organisationsnummer() (*Organisationsnummer, error) {
+	return FromUint64(uint64(k))
+}
+
+// Equal determine if two organization numbers are the same, sole traders
+// are equal regardless of the personnummer was given with the century.
+func (o *Organisationsnummer) Equal(other *Organisationsnummer) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	return o.Key() == other.Key()
+}
+
+// Compare returns -1, 0 or 1 when a is ordered before, equal to or after b,
+// for use with slices.SortFunc. Organization numbers are ordered by number
+// followed by sole traders ordered by personnummer, nil is ordered first.
+func Compare(a, b *Organisationsnummer) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	return cmp.Compare(a.Uint64(), b.Uint64())
+}
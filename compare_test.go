@@ -0,0 +1,70 @@
+package organisationsnummer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"556016-0680", "5560160680", true},
+		{"556016-0680", "16556016-0680", true},
+		{"121212-1212", "201212121212", true},
+		{"121212+1212", "19121212-1212", true},
+		{"121212-1212", "19121212-1212", false},
+		{"556016-0680", "202100-5489", false},
+	}
+
+	for _, test := range tests {
+		a, _ := Parse(test.a)
+		b, _ := Parse(test.b)
+
+		assert.Equal(t, test.equal, a.Equal(b), test.a, test.b)
+		assert.Equal(t, test.equal, a.Key() == b.Key(), test.a, test.b)
+		assert.Equal(t, test.equal, Compare(a, b) == 0, test.a, test.b)
+	}
+
+	var o *Organisationsnummer
+	assert.True(t, o.Equal(nil))
+}
+
+func TestKey(t *testing.T) {
+	seen := map[Key]string{}
+
+	for _, input := range []string{"556016-0680", "5560160680", "165560160680", "202100-5489", "19121212-1212", "121212+1212"} {
+		o, _ := Parse(input)
+		seen[o.Key()] = input
+	}
+
+	assert.Equal(t, 3, len(seen))
+
+	o, _ := Parse("121212+1212")
+	n, err := o.Key().Organisationsnummer()
+	assert.Nil(t, err)
+	assert.True(t, o.Equal(n))
+}
+
+func TestCompare(t *testing.T) {
+	var numbers []*Organisationsnummer
+	for _, input := range []string{"19121212-1212", "556016-0680", "121212-1212", "202100-5489"} {
+		o, _ := Parse(input)
+		numbers = append(numbers, o)
+	}
+	numbers = append(numbers, nil)
+
+	slices.SortFunc(numbers, Compare)
+
+	assert.Nil(t, numbers[0])
+
+	var result []string
+	for _, o := range numbers[1:] {
+		result = append(result, o.FormatAs(FormatLong))
+	}
+
+	assert.Equal(t, []string{"162021005489", "165560160680", "191212121212", "201212121212"}, result)
+}
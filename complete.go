@@ -0,0 +1,71 @@
+package organisationsnummer
+
+// CalculateCheckDigit returns the luhn check digit for the first nine
+// digits of a organization number.
+func CalculateCheckDigit(prefix string) (int, error) {
+	number, err := completePrefix(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(number[9] - '0'), nil
+}
+
+// Complete appends the check digit to the first nine digits of a
+// organization number, keeping any separator, and validates the result.
+func Complete(prefix string, options ...*Options) (string, error) {
+	digit, err := CalculateCheckDigit(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	number := prefix + string(byte(digit)+'0')
+
+	if _, err := New(number, options...); err != nil {
+		return "", err
+	}
+
+	return number, nil
+}
+
+// isUnknownDigit determine if the character marks a unknown digit.
+func isUnknownDigit(c byte) bool {
+	return c == '?' || c == '_'
+}
+
+// Completions returns every valid organization number matching a pattern
+// where exactly one digit is unknown and marked with '?' or '_',
+// like 556016-06?0.
+func Completions(pattern string, options ...*Options) ([]*Organisationsnummer, error) {
+	unknown := -1
+
+	for i := 0; i < len(pattern); i++ {
+		if !isUnknownDigit(pattern[i]) {
+			continue
+		}
+
+		if unknown >= 0 {
+			return nil, newParseError(pattern, ReasonUnknownDigits, i)
+		}
+
+		unknown = i
+	}
+
+	if unknown < 0 {
+		return nil, newParseError(pattern, ReasonUnknownDigits, -1)
+	}
+
+	candidate := []byte(pattern)
+
+	var result []*Organisationsnummer
+
+	for d := byte('0'); d <= '9'; d++ {
+		candidate[unknown] = d
+
+		if o, err := New(string(candidate), options...); err == nil {
+			result = append(result, o)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestCalculateCheckDigit(t *testing.T) {
+	digit, err := CalculateCheckDigit("556016068")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, digit)
+
+	digit, err = CalculateCheckDigit("202100-548")
+	assert.Nil(t, err)
+	assert.Equal(t, 9, digit)
+
+	_, err = CalculateCheckDigit("5560160680")
+	assertReason(t, err, ReasonLength)
+}
+
+func TestComplete(t *testing.T) {
+	number, err := Complete("556016-068")
+	assert.Nil(t, err)
+	assert.Equal(t, "556016-0680", number)
+
+	number, err = Complete("202100548")
+	assert.Nil(t, err)
+	assert.Equal(t, "2021005489", number)
+
+	_, err = Complete("056016068")
+	assertReason(t, err, ReasonLeadingZero)
+}
+
+func TestCompletions(t *testing.T) {
+	result, err := Completions("556016-06?0")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, "5560160680", result[0].Format(false))
+
+	result, err = Completions("556016-068_")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, "5560160680", result[0].Format(false))
+
+	result, err = Completions("?560160680")
+	assert.Nil(t, err)
+	for _, o := range result {
+		assert.True(t, o.Format(false)[0] != '0')
+		assert.True(t, Valid(o.Format(false)))
+	}
+
+	_, err = Completions("556016-0680")
+	assertReason(t, err, ReasonUnknownDigits)
+
+	_, err = Completions("5560?6-06?0")
+	assertReason(t, err, ReasonUnknownDigits)
+	assert.Equal(t, 9, err.(*ParseError).Offset)
+}
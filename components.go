@@ -0,0 +1,78 @@
+package organisationsnummer
+
+// luhnCheckDigit returns the check digit that makes s followed by it
+// a valid luhn string.
+func luhnCheckDigit(s []byte) byte {
+	sum := luhnSum(s, (len(s)+1)&1)
+	return byte((10-sum%10)%10) + '0'
+}
+
+// completePrefix validates the first nine digits of a organization number
+// and returns them followed by the check digit.
+func completePrefix(prefix string) ([]byte, error) {
+	number, offset := getCleanNumber(prefix)
+	if number == nil {
+		return nil, newParseError(prefix, ReasonIllegalCharacter, offset)
+	}
+
+	if len(number) != 9 {
+		return nil, newParseError(prefix, ReasonLength, -1)
+	}
+
+	return append(number, luhnCheckDigit(number)), nil
+}
+
+// FromPrefix builds a organization number from the first nine digits
+// by computing the check digit. Prefixes of a personnummer builds a sole
+// trader, whose GroupNumber is 0, unless DisablePersonnummer is used.
+func FromPrefix(prefix string, options ...*Options) (*Organisationsnummer, error) {
+	number, err := completePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(string(number), options...)
+}
+
+// GroupNumber returns the group number, the first digit of a organization
+// number, or 0 for sole traders and the zero value.
+func (o *Organisationsnummer) GroupNumber() int {
+	if o.IsPersonnummer() || o.IsZero() {
+		return 0
+	}
+
+	return int(o.number[0] - '0')
+}
+
+// ThirdDigit returns the third digit, which is 2 or more for organization
+// numbers so they can not be mistaken for a personnummer.
+func (o *Organisationsnummer) ThirdDigit() int {
+	if o.IsZero() {
+		return 0
+	}
+
+	return int(o.number[2] - '0')
+}
+
+// SerialNumber returns the second to ninth digits of a organization number
+// or the three digit birth number for sole traders.
+func (o *Organisationsnummer) SerialNumber() string {
+	if o.IsPersonnummer() {
+		return o.personnummer.Num
+	}
+
+	if o.IsZero() {
+		return ""
+	}
+
+	return o.number[1:9]
+}
+
+// CheckDigit returns the luhn check digit, the last digit.
+func (o *Organisationsnummer) CheckDigit() int {
+	if o.IsZero() {
+		return 0
+	}
+
+	return int(o.number[9] - '0')
+}
@@ -0,0 +1,64 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestComponents(t *testing.T) {
+	o, _ := Parse("556016-0680")
+	assert.Equal(t, 5, o.GroupNumber())
+	assert.Equal(t, 6, o.ThirdDigit())
+	assert.Equal(t, "56016068", o.SerialNumber())
+	assert.Equal(t, 0, o.CheckDigit())
+
+	o, _ = Parse("19121212-1212")
+	assert.Equal(t, 0, o.GroupNumber())
+	assert.Equal(t, 1, o.ThirdDigit())
+	assert.Equal(t, "121", o.SerialNumber())
+	assert.Equal(t, 2, o.CheckDigit())
+}
+
+func TestFromPrefix(t *testing.T) {
+	tests := map[string]string{
+		"556016068":  "5560160680",
+		"556016-068": "5560160680",
+		"202100548":  "2021005489",
+		"402000000":  "4020000008",
+	}
+
+	for prefix, expected := range tests {
+		o, err := FromPrefix(prefix)
+		assert.Nil(t, err, prefix)
+		assert.Equal(t, expected, o.Format(false), prefix)
+	}
+
+	_, err := FromPrefix("55601606")
+	assertReason(t, err, ReasonLength)
+
+	_, err = FromPrefix("55601606a")
+	assertReason(t, err, ReasonIllegalCharacter)
+
+	_, err = FromPrefix("550016068")
+	assertReason(t, err, ReasonGroupDigits)
+
+	_, err = FromPrefix("556016068", &Options{AllowedTypes: []OrganizationType{Stat}})
+	assertReason(t, err, ReasonType)
+
+	o, err := FromPrefix("121212121")
+	assert.Nil(t, err)
+	assert.True(t, o.IsPersonnummer())
+	assert.Equal(t, 0, o.GroupNumber())
+	assert.Equal(t, "1212121212", o.Format(false))
+
+	_, err = FromPrefix("121212121", &Options{DisablePersonnummer: true})
+	assertReason(t, err, ReasonPersonnummer)
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	for _, number := range []string{"5560160680", "2021005489", "4020000008", "1212121212"} {
+		assert.Equal(t, number[9], luhnCheckDigit([]byte(number[:9])), number)
+		assert.True(t, luhn([]byte(number)), number)
+	}
+}
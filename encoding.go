@@ -0,0 +1,105 @@
+package organisationsnummer
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// textOptions are the options text is unmarshaled with, interim numbers
+// are accepted since MarshalText writes them.
+var textOptions = &Options{AllowInterimNumber: true}
+
+// textFormat returns the format used by MarshalText, sole traders are
+// always written with the century so people 100 years or older keeps
+// their year of birth.
+func (o *Organisationsnummer) textFormat() FormatStyle {
+	switch {
+	case !o.IsPersonnummer():
+		return o.format
+	case o.format == FormatLongHyphen:
+		return FormatLongHyphen
+	default:
+		return FormatLong
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler using the TextFormat
+// option the number was parsed with, sole traders are written as
+// FormatLong unless FormatLongHyphen is used.
+func (o Organisationsnummer) MarshalText() ([]byte, error) {
+	if o.IsZero() {
+		return []byte{}, nil
+	}
+
+	return o.AppendFormat(nil, o.textFormat()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the text is validated
+// and a *ParseError is returned for invalid numbers. Every format written
+// by MarshalText is accepted, including VAT numbers and interim numbers.
+// Empty text decodes to the zero value, which MarshalText writes, so empty
+// defaults of text based configuration like flag.TextVar works. The
+// TextFormat of the receiver is kept.
+func (o *Organisationsnummer) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Organisationsnummer{format: o.format}
+		return nil
+	}
+
+	var n *Organisationsnummer
+	var err error
+
+	if len(text) >= 2 && strings.EqualFold(string(text[:2]), "SE") {
+		n, err = ParseVatNumber(string(text), textOptions)
+	} else {
+		n, err = ParseBytes(text, textOptions)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	n.format = o.format
+	*o = *n
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the number is encoded as a string
+// and the zero value as null.
+func (o Organisationsnummer) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte("null"), nil
+	}
+
+	text, err := o.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, both strings and numbers are
+// accepted and null is ignored. Unlike UnmarshalText a empty string is
+// rejected, the zero value is encoded as null.
+func (o *Organisationsnummer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] >= '0' && data[0] <= '9' {
+		return o.UnmarshalText(data)
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return newParseError(string(data), ReasonIllegalCharacter, 0)
+	}
+
+	if s == "" {
+		return newParseError(s, ReasonLength, -1)
+	}
+
+	return o.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,105 @@
+package organisationsnummer
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+type encodingTest struct {
+	Number   Organisationsnummer  `json:"number"`
+	Optional *Organisationsnummer `json:"optional"`
+}
+
+func TestMarshalText(t *testing.T) {
+	o, _ := Parse("556016-0680")
+	text, err := o.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "5560160680", string(text))
+
+	o, _ = Parse("556016-0680", &Options{TextFormat: FormatHyphen})
+	text, _ = o.MarshalText()
+	assert.Equal(t, "556016-0680", string(text))
+
+	o, _ = Parse("19121212-1212", &Options{TextFormat: FormatLong})
+	text, _ = o.MarshalText()
+	assert.Equal(t, "191212121212", string(text))
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var o Organisationsnummer
+	assert.Nil(t, o.UnmarshalText([]byte("556016-0680")))
+	assert.Equal(t, "5560160680", o.Format(false))
+
+	err := o.UnmarshalText([]byte("556016-0681"))
+	assertReason(t, err, ReasonChecksum)
+	assert.Equal(t, "5560160680", o.Format(false))
+
+	var fs flag.FlagSet
+	fs.TextVar(&o, "org", &Organisationsnummer{}, "organization number")
+	assert.Nil(t, fs.Parse([]string{"-org", "202100-5489"}))
+	assert.Equal(t, "2021005489", o.Format(false))
+}
+
+func TestJSON(t *testing.T) {
+	o, _ := Parse("556016-0680", &Options{TextFormat: FormatHyphen})
+
+	data, err := json.Marshal(encodingTest{Number: *o, Optional: o})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"number":"556016-0680","optional":"556016-0680"}`, string(data))
+
+	var v encodingTest
+	assert.Nil(t, json.Unmarshal([]byte(`{"number":"202100-5489","optional":null}`), &v))
+	assert.Equal(t, "2021005489", v.Number.Format(false))
+	assert.Nil(t, v.Optional)
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"number":5560160680}`), &v))
+	assert.Equal(t, "5560160680", v.Number.Format(false))
+
+	err = json.Unmarshal([]byte(`{"number":"556016-0681"}`), &v)
+	assertReason(t, err, ReasonChecksum)
+	assert.True(t, errors.Is(err, ErrInvalidOrganizationNumber))
+
+	err = json.Unmarshal([]byte(`{"number":true}`), &v)
+	assertReason(t, err, ReasonIllegalCharacter)
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	inputs := []string{"556016-0680", "16202100-5489", "121212+1212", "19121212-1212", "000101-T220", "701063-2391"}
+	styles := []FormatStyle{FormatShort, FormatHyphen, FormatLong, FormatLongHyphen, FormatVat, FormatVatSpaced, FormatPersonnummer}
+
+	for _, input := range inputs {
+		for _, style := range styles {
+			o, err := Parse(input, &Options{AllowInterimNumber: true, TextFormat: style})
+			assert.Nil(t, err, input)
+
+			text, err := o.MarshalText()
+			assert.Nil(t, err, input, style)
+
+			var n Organisationsnummer
+			assert.Nil(t, n.UnmarshalText(text), input, style)
+			assert.True(t, o.Equal(&n), input, style)
+			assert.Equal(t, o.FormatAs(FormatLong), n.FormatAs(FormatLong), input, style)
+
+			data, err := json.Marshal(o)
+			assert.Nil(t, err, input, style)
+
+			n = Organisationsnummer{}
+			assert.Nil(t, json.Unmarshal(data, &n), input, style)
+			assert.True(t, o.Equal(&n), input, style)
+		}
+	}
+}
+
+func TestMarshalTextSoleTrader(t *testing.T) {
+	o, _ := Parse("121212+1212")
+	text, _ := o.MarshalText()
+	assert.Equal(t, "191212121212", string(text))
+
+	o, _ = Parse("121212+1212", &Options{TextFormat: FormatLongHyphen})
+	text, _ = o.MarshalText()
+	assert.Equal(t, "19121212-1212", string(text))
+}
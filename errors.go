@@ -0,0 +1,88 @@
+package organisationsnummer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidOrganizationNumber is matched by every error returned when
+// parsing fails, use errors.Is to test for it.
+var ErrInvalidOrganizationNumber = errors.New("Invalid Swedish organization number")
+
+// Reason describes why a organization number was rejected.
+type Reason int
+
+const (
+	// ReasonLength is used when the input has the wrong number of digits.
+	ReasonLength Reason = iota + 1
+	// ReasonIllegalCharacter is used when the input contains a character
+	// that is not a digit or a separator.
+	ReasonIllegalCharacter
+	// ReasonPrefix is used when a 12 digit number is not prefixed with 16.
+	ReasonPrefix
+	// ReasonGroupDigits is used when the third and fourth digits are below 20.
+	ReasonGroupDigits
+	// ReasonLeadingZero is used when the number starts with a zero.
+	ReasonLeadingZero
+	// ReasonChecksum is used when the luhn check digit is wrong.
+	ReasonChecksum
+	// ReasonPersonnummer is used when the input was rejected as a personnummer.
+	ReasonPersonnummer
+	// ReasonType is used when the organization type is not allowed by the
+	// AllowedTypes option.
+	ReasonType
+	// ReasonSeparator is used when a separator is misplaced in strict mode.
+	ReasonSeparator
+	// ReasonCountryCode is used when a VAT number is not prefixed with SE.
+	ReasonCountryCode
+	// ReasonVatSuffix is used when a VAT number suffix is not between 01 and 99.
+	ReasonVatSuffix
+	// ReasonUnknownDigits is used when a pattern does not contain exactly
+	// one unknown digit.
+	ReasonUnknownDigits
+	// ReasonEncoding is used when a integer or binary encoding is invalid.
+	ReasonEncoding
+)
+
+// String returns a short english description of the reason.
+func (r Reason) String() string {
+	if s, ok := english.Reasons[r]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("Reason(%d)", int(r))
+}
+
+// ParseError describes why a input could not be parsed as a Swedish
+// organization number.
+type ParseError struct {
+	// Input is the value that was parsed.
+	Input string
+	// Reason is why the input was rejected.
+	Reason Reason
+	// Offset is the byte offset in Input the reason refers to, or -1 when
+	// the reason does not apply to a single character.
+	Offset int
+	// Err is the underlying personnummer error, if any.
+	Err error
+}
+
+func newParseError(input string, reason Reason, offset int) *ParseError {
+	return &ParseError{Input: input, Reason: reason, Offset: offset}
+}
+
+// Error implements the error interface, use Localized for other
+// languages than English.
+func (e *ParseError) Error() string {
+	return english.ErrorMessage(e)
+}
+
+// Is reports whether target is ErrInvalidOrganizationNumber.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidOrganizationNumber
+}
+
+// Unwrap returns the underlying personnummer error, if any.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,48 @@
+package organisationsnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestParseErrorReason(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason Reason
+		offset int
+	}{
+		{"556016", ReasonLength, -1},
+		{"556016-06800000", ReasonLength, -1},
+		{"55601606800", ReasonLength, -1},
+		{"5-5-6-0-1-6-0-6-8-0", ReasonLength, -1},
+		{"556016-0680------", ReasonLength, -1},
+		{"556016-068a", ReasonIllegalCharacter, 10},
+		{"175560160680", ReasonPrefix, 0},
+		{"198505035667", ReasonPersonnummer, -1},
+		{"551016-0680", ReasonGroupDigits, 2},
+		{"056016-0680", ReasonLeadingZero, 0},
+		{"556016-0681", ReasonChecksum, 10},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.input)
+
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr), test.input)
+		assert.True(t, errors.Is(err, ErrInvalidOrganizationNumber), test.input)
+		assert.Equal(t, test.reason, perr.Reason, test.input)
+		assert.Equal(t, test.offset, perr.Offset, test.input)
+		assert.Equal(t, test.input, perr.Input)
+	}
+}
+
+func TestParseErrorUnwrap(t *testing.T) {
+	_, err := Parse("198505035667")
+
+	var perr *ParseError
+	assert.True(t, errors.As(err, &perr))
+	assert.NotNil(t, errors.Unwrap(err))
+	assert.Equal(t, "Invalid Swedish organization number: invalid personnummer", err.Error())
+}
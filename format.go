@@ -0,0 +1,94 @@
+package organisationsnummer
+
+// FormatStyle represents a format a organization number can be written in.
+type FormatStyle int
+
+const (
+	// FormatShort is ten digits, 5560160680.
+	FormatShort FormatStyle = iota
+	// FormatHyphen is ten digits with a separator, 556016-0680.
+	FormatHyphen
+	// FormatLong is twelve digits, 165560160680, sole traders are
+	// prefixed with the century instead of 16.
+	FormatLong
+	// FormatLongHyphen is twelve digits with a separator, 16556016-0680.
+	FormatLongHyphen
+	// FormatVat is the VAT number, SE556016068001.
+	FormatVat
+	// FormatVatSpaced is the VAT number with spaces, SE 5560160680 01.
+	FormatVatSpaced
+	// FormatPersonnummer is the twelve digit personnummer of a sole
+	// trader, other organization numbers are formatted as FormatLong.
+	FormatPersonnummer
+)
+
+// prefix returns the two digits a twelve digit number is prefixed with.
+func (o *Organisationsnummer) prefix() string {
+	if o.IsPersonnummer() {
+		return o.personnummer.Century
+	}
+
+	return "16"
+}
+
+// separator returns the separator used with ten digits, personnummer
+// uses a plus for people 100 years or older.
+func (o *Organisationsnummer) separator() string {
+	if o.IsPersonnummer() {
+		return o.personnummer.Sep
+	}
+
+	return "-"
+}
+
+// AppendFormat appends the organization number formatted in the given
+// style to dst and returns the extended buffer, nothing is appended for
+// the zero value.
+func (o *Organisationsnummer) AppendFormat(dst []byte, style FormatStyle) []byte {
+	if o.IsZero() {
+		return dst
+	}
+
+	number := o.number
+
+	switch style {
+	case FormatHyphen:
+		dst = append(dst, number[0:6]...)
+		dst = append(dst, o.separator()...)
+		return append(dst, number[6:]...)
+	case FormatLong, FormatPersonnummer:
+		dst = append(dst, o.prefix()...)
+		return append(dst, number...)
+	case FormatLongHyphen:
+		dst = append(dst, o.prefix()...)
+		dst = append(dst, number[0:6]...)
+		dst = append(dst, '-')
+		return append(dst, number[6:]...)
+	case FormatVat:
+		return o.AppendVatNumber(dst)
+	case FormatVatSpaced:
+		dst = append(dst, "SE "...)
+		dst = append(dst, number...)
+		return append(dst, " 01"...)
+	default:
+		return append(dst, number...)
+	}
+}
+
+// AppendVatNumber appends the VAT number to dst and returns the extended
+// buffer, nothing is appended for the zero value.
+func (o *Organisationsnummer) AppendVatNumber(dst []byte) []byte {
+	if o.IsZero() {
+		return dst
+	}
+
+	dst = append(dst, "SE"...)
+	dst = append(dst, o.number...)
+	return append(dst, "01"...)
+}
+
+// FormatAs formats a Swedish organization number in the given style.
+func (o *Organisationsnummer) FormatAs(style FormatStyle) string {
+	var buf [16]byte
+	return string(o.AppendFormat(buf[:0], style))
+}
@@ -0,0 +1,76 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestFormatAs(t *testing.T) {
+	tests := []struct {
+		input    string
+		style    FormatStyle
+		expected string
+	}{
+		{"556016-0680", FormatShort, "5560160680"},
+		{"556016-0680", FormatHyphen, "556016-0680"},
+		{"556016-0680", FormatLong, "165560160680"},
+		{"556016-0680", FormatLongHyphen, "16556016-0680"},
+		{"556016-0680", FormatVat, "SE556016068001"},
+		{"556016-0680", FormatVatSpaced, "SE 5560160680 01"},
+		{"556016-0680", FormatPersonnummer, "165560160680"},
+		{"19121212-1212", FormatShort, "1212121212"},
+		{"19121212-1212", FormatHyphen, "121212+1212"},
+		{"19121212-1212", FormatLong, "191212121212"},
+		{"19121212-1212", FormatLongHyphen, "19121212-1212"},
+		{"19121212-1212", FormatVat, "SE121212121201"},
+		{"19121212-1212", FormatVatSpaced, "SE 1212121212 01"},
+		{"19121212-1212", FormatPersonnummer, "191212121212"},
+	}
+
+	for _, test := range tests {
+		o, err := Parse(test.input)
+		assert.Nil(t, err, test.input)
+		assert.Equal(t, test.expected, o.FormatAs(test.style), test.input)
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	for _, input := range []string{"556016-0680", "19121212-1212", "121212-1212"} {
+		o, _ := Parse(input)
+
+		for style := FormatShort; style <= FormatPersonnummer; style++ {
+			assert.Equal(t, "x:"+o.FormatAs(style), string(o.AppendFormat([]byte("x:"), style)), input)
+		}
+
+		assert.Equal(t, o.VatNumber(), string(o.AppendVatNumber(nil)), input)
+	}
+}
+
+func TestAppendFormatAllocations(t *testing.T) {
+	buf := make([]byte, 0, 32)
+
+	for _, input := range []string{"556016-0680", "19121212-1212"} {
+		o, _ := Parse(input)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			for style := FormatShort; style <= FormatPersonnummer; style++ {
+				o.AppendFormat(buf[:0], style)
+			}
+			o.AppendVatNumber(buf[:0])
+		})
+
+		assert.Equal(t, float64(0), allocs, input)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	o, _ := Parse("556016-0680")
+	buf := make([]byte, 0, 32)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf = o.AppendFormat(buf[:0], FormatHyphen)
+	}
+}
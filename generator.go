@@ -0,0 +1,123 @@
+package organisationsnummer
+
+import (
+	"fmt"
+	"math/rand"
+	randv2 "math/rand/v2"
+	"reflect"
+	"time"
+)
+
+var (
+	generatorTypes = []OrganizationType{
+		Dodsbo,
+		Stat,
+		UtlandsktForetag,
+		Aktiebolag,
+		EnkeltBolag,
+		EkonomiskForening,
+		IdeellForening,
+		Handelsbolag,
+	}
+	generatorFrom = time.Date(1930, 1, 1, 0, 0, 0, 0, time.UTC)
+	generatorTo   = time.Date(2005, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// Generator generates random valid organization numbers, the same source
+// seed gives the same numbers.
+type Generator struct {
+	// SoleTraders makes Next generate sole traders too.
+	SoleTraders bool
+
+	rand *randv2.Rand
+}
+
+// NewGenerator returns a generator using the given source, both
+// *rand.Rand from math/rand and math/rand/v2 can be used as source.
+func NewGenerator(src randv2.Source) *Generator {
+	return &Generator{rand: randv2.New(src)}
+}
+
+// Next generates a organization number of a random known type.
+func (g *Generator) Next() *Organisationsnummer {
+	n := len(generatorTypes)
+	if g.SoleTraders {
+		n++
+	}
+
+	i := g.rand.IntN(n)
+	if i == len(generatorTypes) {
+		return g.Type(SoleTrader)
+	}
+
+	return g.Type(generatorTypes[i])
+}
+
+// Type generates a organization number of the given type, Unknown
+// generates group number 4. Type panics if the type is not valid.
+func (g *Generator) Type(t OrganizationType) *Organisationsnummer {
+	switch {
+	case t == SoleTrader:
+		return g.soleTrader()
+	case t == Unknown:
+		return g.Group(4)
+	case t.Code() == 0:
+		panic(fmt.Sprintf("organisationsnummer: invalid organization type %d", int(t)))
+	}
+
+	return g.Group(t.Code())
+}
+
+// Group generates a organization number with the given group number.
+// Group panics if the group number is not between 1 and 9.
+func (g *Generator) Group(group int) *Organisationsnummer {
+	if group < 1 || group > 9 {
+		panic(fmt.Sprintf("organisationsnummer: invalid group number %d", group))
+	}
+
+	var number [10]byte
+
+	number[0] = byte(group) + '0'
+	number[1] = byte(g.rand.IntN(10)) + '0'
+	// Third and fourth digits must be 20 or more.
+	number[2] = byte(2+g.rand.IntN(8)) + '0'
+
+	for i := 3; i < 9; i++ {
+		number[i] = byte(g.rand.IntN(10)) + '0'
+	}
+
+	number[9] = luhnCheckDigit(number[:9])
+
+	return g.must(string(number[:]))
+}
+
+// soleTrader generates a sole trader with a random personnummer.
+func (g *Generator) soleTrader() *Organisationsnummer {
+	days := int(generatorTo.Sub(generatorFrom).Hours() / 24)
+	date := generatorFrom.AddDate(0, 0, g.rand.IntN(days+1))
+
+	// The birth number may not be 000.
+	number := []byte(fmt.Sprintf("%s%03d", date.Format("20060102"), 1+g.rand.IntN(999)))
+	number = append(number, luhnCheckDigit(number[2:]))
+
+	return g.must(string(number))
+}
+
+// must parse a generated number and panics on errors.
+func (g *Generator) must(input string) *Organisationsnummer {
+	o, err := New(input)
+	if err != nil {
+		panic(fmt.Sprintf("organisationsnummer: generated invalid number %s: %v", input, err))
+	}
+
+	return o
+}
+
+// Generate implements testing/quick.Generator so *Organisationsnummer
+// can be used as argument in quick.Check functions.
+func (*Organisationsnummer) Generate(r *rand.Rand, size int) reflect.Value {
+	g := NewGenerator(r)
+	g.SoleTraders = true
+
+	return reflect.ValueOf(g.Next())
+}
@@ -0,0 +1,53 @@
+package organisationsnummer
+
+import (
+	"math/rand/v2"
+	"testing"
+	"testing/quick"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestGenerator(t *testing.T) {
+	g := NewGenerator(rand.NewPCG(1, 2))
+
+	for _, typ := range append(generatorTypes, Unknown, SoleTrader) {
+		for i := 0; i < 100; i++ {
+			o := g.Type(typ)
+			assert.Equal(t, typ, o.Type())
+			assert.True(t, Valid(o.Format(true)), o.Format(true))
+			assert.True(t, Valid(o.FormatAs(FormatLong)), o.FormatAs(FormatLong))
+		}
+	}
+
+	for group := 1; group <= 9; group++ {
+		assert.Equal(t, group, g.Group(group).GroupNumber())
+	}
+}
+
+func TestGeneratorSeed(t *testing.T) {
+	a := NewGenerator(rand.NewPCG(1, 2))
+	b := NewGenerator(rand.NewPCG(1, 2))
+	a.SoleTraders = true
+	b.SoleTraders = true
+
+	soleTraders := 0
+	for i := 0; i < 100; i++ {
+		o := a.Next()
+		assert.Equal(t, o.FormatAs(FormatLong), b.Next().FormatAs(FormatLong))
+
+		if o.IsPersonnummer() {
+			soleTraders++
+		}
+	}
+
+	assert.True(t, soleTraders > 0)
+}
+
+func TestGeneratorQuick(t *testing.T) {
+	f := func(o *Organisationsnummer) bool {
+		return Valid(o.Format(true))
+	}
+
+	assert.Nil(t, quick.Check(f, nil))
+}
@@ -1,6 +1,6 @@
 module github.com/organisationsnummer/go
 
-go 1.22
+go 1.23
 
 require (
 	github.com/frozzare/go v1.0.0
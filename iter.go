@@ -0,0 +1,162 @@
+package organisationsnummer
+
+import (
+	"iter"
+)
+
+// isWildcard determine if the character matches any digit in a series.
+func isWildcard(c byte) bool {
+	return c == 'x' || c == 'X' || isUnknownDigit(c)
+}
+
+// seriesDigits returns the ten digits or wildcards of a input, separators
+// are ignored.
+func seriesDigits(input string, wildcards bool) ([10]byte, error) {
+	var digits [10]byte
+	n := 0
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		switch {
+		case c == '-' || c == '+':
+			continue
+		case c >= '0' && c <= '9', wildcards && isWildcard(c):
+			if n == len(digits) {
+				return digits, newParseError(input, ReasonLength, -1)
+			}
+
+			digits[n] = c
+			n++
+		default:
+			return digits, newParseError(input, ReasonIllegalCharacter, i)
+		}
+	}
+
+	if n != len(digits) {
+		return digits, newParseError(input, ReasonLength, -1)
+	}
+
+	return digits, nil
+}
+
+// lowestDigit returns the lowest digit allowed at a position, organization
+// numbers may not start with 0 and the third digit must be 2 or more.
+func lowestDigit(i int) byte {
+	switch i {
+	case 0:
+		return '1'
+	case 2:
+		return '2'
+	default:
+		return '0'
+	}
+}
+
+// Series returns a iterator over every valid organization number in a
+// series, like 5560xxxxxx, where x, '?' or '_' matches any digit. The
+// numbers are ordered and only the valid check digit is tried.
+func Series(pattern string) (iter.Seq[*Organisationsnummer], error) {
+	digits, err := seriesDigits(pattern, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(*Organisationsnummer) bool) {
+		number := digits
+
+		var wild []int
+		for i := 0; i < 9; i++ {
+			if isWildcard(digits[i]) {
+				wild = append(wild, i)
+				number[i] = lowestDigit(i)
+			} else if digits[i] < lowestDigit(i) {
+				return
+			}
+		}
+
+		for {
+			c := luhnCheckDigit(number[:9])
+
+			if isWildcard(digits[9]) || digits[9] == c {
+				number[9] = c
+
+				if !yield(&Organisationsnummer{number: string(number[:])}) {
+					return
+				}
+			}
+
+			j := len(wild) - 1
+			for ; j >= 0; j-- {
+				if p := wild[j]; number[p] < '9' {
+					number[p]++
+					break
+				} else {
+					number[p] = lowestDigit(p)
+				}
+			}
+
+			if j < 0 {
+				return
+			}
+		}
+	}, nil
+}
+
+// rangeBound returns the ten digit bound of a range as a integer.
+func rangeBound(input string) (uint64, error) {
+	digits, err := seriesDigits(input, false)
+	if err != nil {
+		return 0, err
+	}
+
+	var n uint64
+	for _, c := range digits {
+		n = n*10 + uint64(c-'0')
+	}
+
+	return n, nil
+}
+
+// Range returns a iterator over every valid organization number between
+// from and to, inclusive. The bounds must be ten digits but does not have
+// to be valid organization numbers.
+func Range(from, to string) (iter.Seq[*Organisationsnummer], error) {
+	lo, err := rangeBound(from)
+	if err != nil {
+		return nil, err
+	}
+
+	hi, err := rangeBound(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(*Organisationsnummer) bool) {
+		// p is the first nine digits, which has exactly one check digit.
+		p := max(lo/10, 100000000)
+
+		for p <= hi/10 {
+			// Skip ahead when the third and fourth digits are below 20.
+			if (p/100000)%100 < 20 {
+				p = p/10000000*10000000 + 2000000
+				continue
+			}
+
+			var number [10]byte
+			for i, n := 8, p; i >= 0; i, n = i-1, n/10 {
+				number[i] = byte(n%10) + '0'
+			}
+
+			number[9] = luhnCheckDigit(number[:9])
+
+			if n := p*10 + uint64(number[9]-'0'); n >= lo && n <= hi {
+				if !yield(&Organisationsnummer{number: string(number[:])}) {
+					return
+				}
+			}
+
+			p++
+		}
+	}, nil
+}
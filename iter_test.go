@@ -0,0 +1,75 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func collect(t *testing.T, seq func(func(*Organisationsnummer) bool)) []string {
+	t.Helper()
+
+	var result []string
+	for o := range seq {
+		assert.True(t, Valid(o.Format(false)), o.Format(false))
+		result = append(result, o.Format(false))
+	}
+
+	return result
+}
+
+func TestSeries(t *testing.T) {
+	seq, err := Series("55601606xx")
+	assert.Nil(t, err)
+
+	result := collect(t, seq)
+	assert.Equal(t, 10, len(result))
+	assert.Equal(t, "5560160607", result[0])
+	assert.Equal(t, "5560160680", result[8])
+
+	seq, _ = Series("556016-0680")
+	assert.Equal(t, []string{"5560160680"}, collect(t, seq))
+
+	seq, _ = Series("556016-0681")
+	assert.Equal(t, 0, len(collect(t, seq)))
+
+	seq, _ = Series("x5x0160680")
+	for _, number := range collect(t, seq) {
+		assert.True(t, number[0] != '0')
+		assert.True(t, number[2] >= '2')
+	}
+
+	seq, _ = Series("5560xxxxxx")
+	assert.Equal(t, 100000, len(collect(t, seq)))
+
+	_, err = Series("5560xxxxx")
+	assertReason(t, err, ReasonLength)
+}
+
+func TestRange(t *testing.T) {
+	seq, err := Range("5560160600", "556016-0699")
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(collect(t, seq)))
+
+	seq, _ = Range("5519999990", "5520000009")
+	assert.Equal(t, []string{"5520000000"}, collect(t, seq))
+
+	seq, _ = Range("0000000000", "1020000009")
+	assert.Equal(t, []string{"1020000004"}, collect(t, seq))
+
+	seq, _ = Range("5560160681", "5560160689")
+	assert.Equal(t, 0, len(collect(t, seq)))
+
+	seq, _ = Range("5560160000", "5560169999")
+	count := 0
+	for range seq {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	assert.Equal(t, 5, count)
+
+	_, err = Range("556016068a", "5560160699")
+	assertReason(t, err, ReasonIllegalCharacter)
+}
@@ -0,0 +1,211 @@
+package organisationsnummer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Localizer provides localized organization type labels and error messages.
+type Localizer interface {
+	// TypeLabel returns the label of a organization type.
+	TypeLabel(t OrganizationType) string
+	// ErrorMessage returns the message of a parse error.
+	ErrorMessage(err *ParseError) string
+}
+
+// Catalog is a Localizer backed by translation tables, missing entries
+// falls back to English.
+type Catalog struct {
+	// Invalid is the message used for all parse errors.
+	Invalid string
+	// Offset is the format used for the error offset, like "at offset %d",
+	// it must contain exactly one %d verb and a literal percent as %%.
+	Offset string
+	// Types contains the organization type labels.
+	Types map[OrganizationType]string
+	// Reasons contains the parse error reasons.
+	Reasons map[Reason]string
+}
+
+var (
+	english = &Catalog{
+		Invalid: "Invalid Swedish organization number",
+		Offset:  "at offset %d",
+		Types: map[OrganizationType]string{
+			Unknown:           "Unknown",
+			Dodsbo:            "Estates of deceased persons",
+			Stat:              "State, county councils, municipalities or parishes",
+			UtlandsktForetag:  "Foreign companies conducting business or owning property in Sweden",
+			Aktiebolag:        "Limited companies",
+			EnkeltBolag:       "Simple partnerships",
+			EkonomiskForening: "Economic associations or housing cooperatives",
+			IdeellForening:    "Non-profit associations and foundations",
+			Handelsbolag:      "Trading partnerships, limited partnerships and simple partnerships",
+			SoleTrader:        "Sole trader",
+		},
+		Reasons: map[Reason]string{
+			ReasonLength:           "invalid length",
+			ReasonIllegalCharacter: "illegal character",
+			ReasonPrefix:           "12 digit numbers must be prefixed with 16",
+			ReasonGroupDigits:      "third and fourth digits must be 20 or more",
+			ReasonLeadingZero:      "may not start with 0",
+			ReasonChecksum:         "invalid check digit",
+			ReasonPersonnummer:     "invalid personnummer",
+			ReasonType:             "organization type not allowed",
+			ReasonSeparator:        "misplaced separator",
+			ReasonCountryCode:      "VAT number must start with SE",
+			ReasonVatSuffix:        "invalid VAT number suffix",
+			ReasonUnknownDigits:    "must contain exactly one unknown digit",
+			ReasonEncoding:         "invalid encoding",
+		},
+	}
+	swedish = &Catalog{
+		Invalid: "Ogiltigt svenskt organisationsnummer",
+		Offset:  "vid position %d",
+		Types: map[OrganizationType]string{
+			Unknown:           "Okänt",
+			Dodsbo:            "Dödsbon",
+			Stat:              "Stat, landsting, kommun eller församling",
+			UtlandsktForetag:  "Utländska företag som bedriver näringsverksamhet eller äger fastigheter i Sverige",
+			Aktiebolag:        "Aktiebolag",
+			EnkeltBolag:       "Enkelt bolag",
+			EkonomiskForening: "Ekonomisk förening eller bostadsrättsförening",
+			IdeellForening:    "Ideella förening och stiftelse",
+			Handelsbolag:      "Handelsbolag, kommanditbolag och enkelt bolag",
+			SoleTrader:        "Enskild firma",
+		},
+		Reasons: map[Reason]string{
+			ReasonLength:           "felaktig längd",
+			ReasonIllegalCharacter: "otillåtet tecken",
+			ReasonPrefix:           "tolvsiffriga nummer måste börja med 16",
+			ReasonGroupDigits:      "tredje och fjärde siffran måste vara 20 eller mer",
+			ReasonLeadingZero:      "får inte börja med 0",
+			ReasonChecksum:         "felaktig kontrollsiffra",
+			ReasonPersonnummer:     "ogiltigt personnummer",
+			ReasonType:             "organisationsformen är inte tillåten",
+			ReasonSeparator:        "felplacerat skiljetecken",
+			ReasonCountryCode:      "momsregistreringsnummer måste börja med SE",
+			ReasonVatSuffix:        "ogiltigt suffix i momsregistreringsnummer",
+			ReasonUnknownDigits:    "måste innehålla exakt en okänd siffra",
+			ReasonEncoding:         "ogiltig kodning",
+		},
+	}
+
+	localizersMu sync.RWMutex
+	localizers   = map[string]Localizer{
+		"en": english,
+		"sv": swedish,
+	}
+)
+
+// TypeLabel returns the label of a organization type.
+func (c *Catalog) TypeLabel(t OrganizationType) string {
+	if _, ok := english.Types[t]; !ok {
+		t = Unknown
+	}
+
+	if l, ok := c.Types[t]; ok {
+		return l
+	}
+
+	if c != english {
+		return english.TypeLabel(t)
+	}
+
+	return ""
+}
+
+// validOffset determine if the offset format contains exactly one %d verb
+// and no other verbs.
+func validOffset(format string) bool {
+	verbs := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		i++
+
+		switch {
+		case i < len(format) && format[i] == '%':
+		case i < len(format) && format[i] == 'd':
+			verbs++
+		default:
+			return false
+		}
+	}
+
+	return verbs == 1
+}
+
+// ErrorMessage returns the message of a parse error, a invalid Offset
+// format falls back to English.
+func (c *Catalog) ErrorMessage(err *ParseError) string {
+	invalid, offset := c.Invalid, c.Offset
+	if invalid == "" {
+		invalid = english.Invalid
+	}
+	if !validOffset(offset) {
+		offset = english.Offset
+	}
+
+	reason, ok := c.Reasons[err.Reason]
+	if !ok {
+		reason = err.Reason.String()
+	}
+
+	if err.Offset >= 0 {
+		return invalid + ": " + reason + " " + fmt.Sprintf(offset, err.Offset)
+	}
+
+	return fmt.Sprintf("%s: %s", invalid, reason)
+}
+
+// languageTag returns the lower case language of a tag, sv-SE => sv.
+func languageTag(lang string) string {
+	lang = strings.ToLower(lang)
+
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		return lang[:i]
+	}
+
+	return lang
+}
+
+// RegisterLocalizer registers a Localizer for a language, like "fi".
+// Registering a existing language replaces it.
+func RegisterLocalizer(lang string, l Localizer) {
+	localizersMu.Lock()
+	defer localizersMu.Unlock()
+
+	localizers[strings.ToLower(lang)] = l
+}
+
+// GetLocalizer returns the Localizer for a language tag, like "sv-SE",
+// falling back to the language without region and then to English.
+func GetLocalizer(lang string) Localizer {
+	localizersMu.RLock()
+	defer localizersMu.RUnlock()
+
+	if l, ok := localizers[strings.ToLower(lang)]; ok {
+		return l
+	}
+
+	if l, ok := localizers[languageTag(lang)]; ok {
+		return l
+	}
+
+	return english
+}
+
+// TypeLabel returns the organization type label in the given language.
+func (o *Organisationsnummer) TypeLabel(lang string) string {
+	return GetLocalizer(lang).TypeLabel(o.Type())
+}
+
+// Localized returns the error message in the given language.
+func (e *ParseError) Localized(lang string) string {
+	return GetLocalizer(lang).ErrorMessage(e)
+}
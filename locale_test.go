@@ -0,0 +1,72 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestTypeLabel(t *testing.T) {
+	o, _ := Parse("556016-0680")
+
+	assert.Equal(t, "Aktiebolag", o.TypeLabel("sv"))
+	assert.Equal(t, "Aktiebolag", o.TypeLabel("sv-SE"))
+	assert.Equal(t, "Limited companies", o.TypeLabel("en"))
+	assert.Equal(t, "Limited companies", o.TypeLabel("xx"))
+	assert.Equal(t, "Okänt", OrganizationType(4).LocalizedLabel("sv"))
+}
+
+func TestLocalizedError(t *testing.T) {
+	_, err := Parse("556016-0681")
+	perr := err.(*ParseError)
+
+	assert.Equal(t, "Invalid Swedish organization number: invalid check digit at offset 10", perr.Localized("en"))
+	assert.Equal(t, "Ogiltigt svenskt organisationsnummer: felaktig kontrollsiffra vid position 10", perr.Localized("sv"))
+	assert.Equal(t, perr.Error(), perr.Localized("de"))
+}
+
+func TestRegisterLocalizer(t *testing.T) {
+	RegisterLocalizer("fi", &Catalog{
+		Invalid: "Virheellinen ruotsalainen organisaationumero",
+		Offset:  "kohdassa %d",
+		Types: map[OrganizationType]string{
+			Aktiebolag: "Osakeyhtiö",
+		},
+		Reasons: map[Reason]string{
+			ReasonChecksum: "virheellinen tarkistusnumero",
+		},
+	})
+
+	o, _ := Parse("556016-0680")
+	assert.Equal(t, "Osakeyhtiö", o.TypeLabel("fi-FI"))
+
+	o, _ = Parse("202100-5489")
+	assert.Equal(t, "State, county councils, municipalities or parishes", o.TypeLabel("fi"))
+
+	_, err := Parse("556016-0681")
+	assert.Equal(t, "Virheellinen ruotsalainen organisaationumero: virheellinen tarkistusnumero kohdassa 10", err.(*ParseError).Localized("fi"))
+
+	_, err = Parse("556016")
+	assert.Equal(t, "Virheellinen ruotsalainen organisaationumero: invalid length", err.(*ParseError).Localized("fi"))
+}
+
+func TestCatalogOffset(t *testing.T) {
+	_, err := Parse("556016-0681")
+	perr := err.(*ParseError)
+
+	tests := map[string]string{
+		"":              "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+		"at %d":         "Invalid Swedish organization number: 100% invalid check digit at 10",
+		"at %d (100%%)": "Invalid Swedish organization number: 100% invalid check digit at 10 (100%)",
+		"at 100%":       "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+		"at offset":     "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+		"at %d and %d":  "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+		"at %s":         "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+		"at %d %v":      "Invalid Swedish organization number: 100% invalid check digit at offset 10",
+	}
+
+	for offset, expected := range tests {
+		c := &Catalog{Offset: offset, Reasons: map[Reason]string{ReasonChecksum: "100% invalid check digit"}}
+		assert.Equal(t, expected, c.ErrorMessage(perr), offset)
+	}
+}
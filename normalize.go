@@ -0,0 +1,163 @@
+package organisationsnummer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Normalization selects the steps Normalize applies to a input.
+type Normalization uint
+
+const (
+	// NormalizeTrim removes surrounding whitespace and quotes.
+	NormalizeTrim Normalization = 1 << iota
+	// NormalizeSpaces removes all whitespace, including non-breaking
+	// and thin spaces.
+	NormalizeSpaces
+	// NormalizeDashes folds unicode dashes and minus signs into '-'.
+	NormalizeDashes
+	// NormalizeWidth folds full-width digits, minus and plus into ASCII.
+	NormalizeWidth
+
+	// NormalizeAll applies every normalization step.
+	NormalizeAll = NormalizeTrim | NormalizeSpaces | NormalizeDashes | NormalizeWidth
+)
+
+// Change describes a single change made by Normalize.
+type Change struct {
+	// Offset is the byte offset of the change in the original input.
+	Offset int
+	// From is the original text.
+	From string
+	// To is the replacement text, empty when From was removed.
+	To string
+}
+
+// isCleanInput determine if the input only contains digits and separators
+// so there is nothing to normalize.
+func isCleanInput[T text](input T) bool {
+	for i := 0; i < len(input); i++ {
+		if c := input[i]; (c < '0' || c > '9') && c != '-' && c != '+' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isQuote determine if the rune is a quotation mark.
+func isQuote(r rune) bool {
+	return unicode.In(r, unicode.Quotation_Mark) || r == '«' || r == '»'
+}
+
+// isSpace determine if the rune is whitespace, including zero width spaces.
+func isSpace(r rune) bool {
+	return unicode.IsSpace(r) || r == '\u200b' || r == '\u2060' || r == '\ufeff'
+}
+
+// isDash determine if the rune is a dash or minus sign.
+func isDash(r rune) bool {
+	return unicode.Is(unicode.Pd, r) || r == '\u2212'
+}
+
+// Normalize folds the input into ASCII using the given steps and returns
+// the normalized input together with the changes that were made.
+func Normalize(input string, n Normalization) (string, []Change) {
+	if isCleanInput(input) {
+		return input, nil
+	}
+
+	var changes []Change
+
+	start, end := 0, len(input)
+	if n&NormalizeTrim != 0 {
+		for start < end {
+			r, size := utf8.DecodeRuneInString(input[start:end])
+			if !isSpace(r) && !isQuote(r) {
+				break
+			}
+			changes = append(changes, Change{Offset: start, From: input[start : start+size]})
+			start += size
+		}
+
+		for end > start {
+			r, size := utf8.DecodeLastRuneInString(input[start:end])
+			if !isSpace(r) && !isQuote(r) {
+				break
+			}
+			end -= size
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(end - start)
+
+	for i, r := range input[start:end] {
+		offset := start + i
+		from := string(r)
+		to := from
+
+		switch {
+		case n&NormalizeSpaces != 0 && isSpace(r):
+			to = ""
+		case n&NormalizeDashes != 0 && isDash(r) && r != '-':
+			to = "-"
+		case n&NormalizeWidth != 0 && r >= '\uff10' && r <= '\uff19':
+			to = string('0' + r - '\uff10')
+		case n&NormalizeWidth != 0 && r == '\uff0d':
+			to = "-"
+		case n&NormalizeWidth != 0 && r == '\uff0b':
+			to = "+"
+		}
+
+		if to != from {
+			changes = append(changes, Change{Offset: offset, From: from, To: to})
+		}
+
+		b.WriteString(to)
+	}
+
+	for end < len(input) {
+		_, size := utf8.DecodeRuneInString(input[end:])
+		changes = append(changes, Change{Offset: end, From: input[end : end+size]})
+		end += size
+	}
+
+	return b.String(), changes
+}
+
+// originalOffset maps a byte offset in a normalized input back to the
+// byte offset in the original input.
+func originalOffset(changes []Change, offset int) int {
+	if offset < 0 {
+		return offset
+	}
+
+	delta := 0
+
+	for _, c := range changes {
+		at := c.Offset + delta
+		if offset < at {
+			break
+		}
+		if offset < at+len(c.To) {
+			return c.Offset
+		}
+
+		delta += len(c.To) - len(c.From)
+	}
+
+	return offset - delta
+}
+
+// originalError makes a parse error refer to the original input instead
+// of the normalized input.
+func originalError(err error, input string, changes []Change) error {
+	if perr, ok := err.(*ParseError); ok && len(changes) > 0 {
+		perr.Input = input
+		perr.Offset = originalOffset(changes, perr.Offset)
+	}
+
+	return err
+}
@@ -0,0 +1,56 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		changes  int
+	}{
+		{"556016-0680", "556016-0680", 0},
+		{"556016–0680", "556016-0680", 1},
+		{"556016\u22120680", "556016-0680", 1},
+		{"556016 0680", "5560160680", 1},
+		{"556 016 0680", "5560160680", 2},
+		{"５５６０１６－０６８０", "556016-0680", 11},
+		{" \"556016-0680\"\n", "556016-0680", 4},
+		{"“556016-0680”", "556016-0680", 2},
+	}
+
+	for _, test := range tests {
+		normalized, changes := Normalize(test.input, NormalizeAll)
+		assert.Equal(t, test.expected, normalized, test.input)
+		assert.Equal(t, test.changes, len(changes), test.input)
+	}
+}
+
+func TestNormalizeSteps(t *testing.T) {
+	normalized, changes := Normalize(" 556016–0680 ", NormalizeDashes)
+	assert.Equal(t, " 556016-0680 ", normalized)
+	assert.Equal(t, []Change{{Offset: 7, From: "–", To: "-"}}, changes)
+
+	normalized, _ = Normalize(" 556016–0680 ", NormalizeTrim)
+	assert.Equal(t, "556016–0680", normalized)
+}
+
+func TestNormalizeOptions(t *testing.T) {
+	options := &Options{Normalization: NormalizeAll}
+
+	assert.False(t, Valid(" 556016–0680"))
+	assert.True(t, Valid(" 556016–0680", options))
+	assert.True(t, Valid("121212 1212", options))
+	assert.True(t, Valid("5-5-6-0-1-6-0-6-8-0", options))
+	assert.False(t, Valid("5-5-6-0-1-6-0-6-8-0"))
+	assert.False(t, Valid("556016-0680------"))
+
+	input := "“556016–0681”"
+	_, err := Parse(input, options)
+	assertReason(t, err, ReasonChecksum)
+	assert.Equal(t, input, err.(*ParseError).Input)
+	assert.Equal(t, 15, err.(*ParseError).Offset)
+}
@@ -0,0 +1,75 @@
+package organisationsnummer
+
+import (
+	personnummer "github.com/personnummer/go/v3"
+)
+
+// Options represents the organisationsnummer options.
+type Options struct {
+	// DisablePersonnummer rejects sole traders identified by a personnummer.
+	DisablePersonnummer bool
+	// AllowInterimNumber accepts sole traders with a personnummer interim number.
+	AllowInterimNumber bool
+	// DisableCoordinationNumber rejects sole traders with a coordination number.
+	DisableCoordinationNumber bool
+	// DisableCenturyPrefix rejects 12 digit numbers prefixed with 16.
+	DisableCenturyPrefix bool
+	// StrictSeparators only accepts the official layouts, NNNNNN-NNNN and
+	// 16NNNNNN-NNNN or the personnummer layouts for sole traders. The plus
+	// separator is only accepted for personnummer.
+	StrictSeparators bool
+	// Normalization selects how the input is normalized before it is
+	// validated, see Normalize. No normalization is made by default.
+	Normalization Normalization
+	// TextFormat is the format used by MarshalText and MarshalJSON,
+	// FormatShort by default. Sole traders are always written with the
+	// century, as FormatLong unless FormatLongHyphen is used.
+	TextFormat FormatStyle
+	// AllowedTypes limits the accepted organization types, sole traders
+	// are only accepted if SoleTrader is included and group numbers without
+	// a type if Unknown is included. All types are accepted when empty.
+	AllowedTypes []OrganizationType
+}
+
+var defaultOptions = &Options{}
+
+// getOptions returns the first options or the default options.
+func getOptions(options []*Options) *Options {
+	if len(options) > 0 && options[0] != nil {
+		return options[0]
+	}
+
+	return defaultOptions
+}
+
+// personnummerOptions returns the options passed on to personnummer.
+func (o *Options) personnummerOptions() *personnummer.Options {
+	return &personnummer.Options{
+		AllowInterimNumber:        o.AllowInterimNumber,
+		DisableCoordinationNumber: o.DisableCoordinationNumber,
+	}
+}
+
+// normalize normalizes the input if enabled.
+func (o *Options) normalize(input string) (string, []Change) {
+	if o.Normalization == 0 {
+		return input, nil
+	}
+
+	return Normalize(input, o.Normalization)
+}
+
+// allowsType determine if the given organization type is allowed.
+func (o *Options) allowsType(t OrganizationType) bool {
+	if len(o.AllowedTypes) == 0 {
+		return true
+	}
+
+	for _, allowed := range o.AllowedTypes {
+		if allowed == t {
+			return true
+		}
+	}
+
+	return false
+}
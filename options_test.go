@@ -0,0 +1,109 @@
+package organisationsnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func assertReason(t *testing.T, err error, reason Reason, msgAndArgs ...interface{}) {
+	t.Helper()
+
+	var perr *ParseError
+	if assert.True(t, errors.As(err, &perr), msgAndArgs...) {
+		assert.Equal(t, reason, perr.Reason, msgAndArgs...)
+	}
+}
+
+func TestOptionsDisablePersonnummer(t *testing.T) {
+	options := &Options{DisablePersonnummer: true}
+
+	assert.True(t, Valid("121212-1212"))
+	assert.False(t, Valid("121212-1212", options))
+	assert.True(t, Valid("556016-0680", options))
+
+	_, err := Parse("19121212-1212", options)
+	assertReason(t, err, ReasonPersonnummer)
+}
+
+func TestOptionsCoordinationNumber(t *testing.T) {
+	assert.True(t, Valid("701063-2391"))
+
+	_, err := Parse("701063-2391", &Options{DisableCoordinationNumber: true})
+	assertReason(t, err, ReasonPersonnummer)
+}
+
+func TestOptionsInterimNumber(t *testing.T) {
+	assert.False(t, Valid("000101-T220"))
+
+	o, err := Parse("000101-T220", &Options{AllowInterimNumber: true})
+	assert.Nil(t, err)
+	assert.True(t, o.IsPersonnummer())
+}
+
+func TestOptionsDisableCenturyPrefix(t *testing.T) {
+	assert.True(t, Valid("165560160680"))
+
+	_, err := Parse("165560160680", &Options{DisableCenturyPrefix: true})
+	assertReason(t, err, ReasonPrefix)
+}
+
+func TestOptionsStrictSeparators(t *testing.T) {
+	options := &Options{StrictSeparators: true}
+
+	for _, input := range []string{
+		"5560160680",
+		"556016-0680",
+		"165560160680",
+		"16556016-0680",
+		"1212121212",
+		"121212-1212",
+		"121212+1212",
+		"191212121212",
+		"19121212-1212",
+	} {
+		assert.True(t, Valid(input, options), input)
+	}
+
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"55601606-80", 8},
+		{"55-6016-0680", 2},
+		{"556016+0680", 6},
+		{"16556016+0680", 8},
+		{"19121212+1212", 8},
+		{"1655601-60680", 7},
+	}
+
+	for _, test := range tests {
+		assert.True(t, Valid(test.input), test.input)
+
+		_, err := Parse(test.input, options)
+		assertReason(t, err, ReasonSeparator, test.input)
+		assert.Equal(t, test.offset, err.(*ParseError).Offset, test.input)
+	}
+}
+
+func TestOptionsAllowedTypes(t *testing.T) {
+	options := &Options{AllowedTypes: []OrganizationType{Aktiebolag, Handelsbolag}}
+
+	assert.True(t, Valid("556016-0680", options))
+	assert.False(t, Valid("121212-1212", options))
+
+	_, err := Parse("202100-5489", options)
+	assertReason(t, err, ReasonType)
+
+	_, err = Parse("121212-1212", options)
+	assertReason(t, err, ReasonType)
+
+	options = &Options{AllowedTypes: []OrganizationType{SoleTrader}}
+	assert.True(t, Valid("121212-1212", options))
+	assert.False(t, Valid("556016-0680", options))
+
+	options = &Options{AllowedTypes: []OrganizationType{Unknown}}
+	assert.True(t, Valid("402000-0008", options))
+	assert.False(t, Valid("556016-0680", options))
+}
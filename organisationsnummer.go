@@ -1,28 +1,18 @@
 package organisationsnummer
 
 import (
-	"errors"
-	"fmt"
-
 	personnummer "github.com/personnummer/go/v3"
 )
 
 var (
-	ErrInvalidOrganizationNumber = errors.New("Invalid Swedish organization number")
-	rule3                        = [...]int{0, 2, 4, 6, 8, 1, 3, 5, 7, 9}
-	unknown                      = "Okänt"
-	types                        = map[byte]string{
-		'1': "Dödsbon",
-		'2': "Stat, landsting, kommun eller församling",
-		'3': "Utländska företag som bedriver näringsverksamhet eller äger fastigheter i Sverige",
-		'5': "Aktiebolag",
-		'6': "Enkelt bolag",
-		'7': "Ekonomisk förening eller bostadsrättsförening",
-		'8': "Ideella förening och stiftelse",
-		'9': "Handelsbolag, kommanditbolag och enkelt bolag",
-	}
+	rule3 = [...]int{0, 2, 4, 6, 8, 1, 3, 5, 7, 9}
 )
 
+// text is the input types that can be parsed without copying.
+type text interface {
+	~string | ~[]byte
+}
+
 // charsToDigit converts char bytes to a digit
 // example: ['1", '1'] => 11
 func charsToDigit(chars []byte) int {
@@ -38,11 +28,12 @@ func charsToDigit(chars []byte) int {
 	return r
 }
 
-// getCleanNumber will return clean numbers.
-func getCleanNumber(in string) []byte {
+// getCleanNumber will return clean numbers, or nil and the offset
+// of the first illegal character.
+func getCleanNumber(in string) ([]byte, int) {
 	cleanNumber := make([]byte, 0, len(in))
 
-	for _, c := range in {
+	for i, c := range in {
 		if c == '+' {
 			continue
 		}
@@ -51,22 +42,78 @@ func getCleanNumber(in string) []byte {
 		}
 
 		if c > '9' {
-			return nil
+			return nil, i
 		}
 		if c < '0' {
-			return nil
+			return nil, i
 		}
 
 		cleanNumber = append(cleanNumber, byte(c))
 	}
 
-	return cleanNumber
+	return cleanNumber, -1
 }
 
-// luhn will test if the given string is a valid luhn string.
-func luhn(s []byte) bool {
-	odd := len(s) & 1
+// separatorOffset returns the byte offset of the first separator that is
+// not placed where the official layouts allow it, or -1.
+//
+// The layouts are NNNNNN-NNNN and 16NNNNNN-NNNN for organization numbers
+// and YYMMDD-NNNN, YYMMDD+NNNN and CCYYMMDD-NNNN for personnummer, all
+// of them may also be written without a separator.
+func separatorOffset[T text](in T) int {
+	sep := -1
+
+	switch len(in) {
+	case 11:
+		sep = 6
+	case 13:
+		sep = 8
+	}
+
+	for i := 0; i < len(in); i++ {
+		c := in[i]
+		if c != '-' && c != '+' {
+			continue
+		}
+
+		// Plus only carries a meaning in the short personnummer layout.
+		if i != sep || (c == '+' && len(in) != 11) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// digitOffset returns the byte offset in the input of the n:th digit.
+func digitOffset[T text](in T, n int) int {
+	for i := 0; i < len(in); i++ {
+		if in[i] < '0' || in[i] > '9' {
+			continue
+		}
+		if n == 0 {
+			return i
+		}
+		n--
+	}
+
+	return -1
+}
 
+// indexByte returns the byte offset of the first c in the input, or -1.
+func indexByte[T text](in T, c byte) int {
+	for i := 0; i < len(in); i++ {
+		if in[i] == c {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// luhnSum returns the luhn sum of s, the digits at indexes with the same
+// parity as odd are doubled.
+func luhnSum(s []byte, odd int) int {
 	var sum int
 
 	for i, c := range s {
@@ -77,114 +124,228 @@ func luhn(s []byte) bool {
 		}
 	}
 
-	return sum%10 == 0
+	return sum
+}
+
+// luhn will test if the given string is a valid luhn string.
+func luhn(s []byte) bool {
+	return luhnSum(s, len(s)&1)%10 == 0
 }
 
 // Organisationsnummer represents the organisationsnummer struct.
 type Organisationsnummer struct {
 	number       string
 	personnummer *personnummer.Personnummer
+	format       FormatStyle
 }
 
 // New parse a Swedish organization numbers and returns a new struct or a error.
-func New(input string) (*Organisationsnummer, error) {
-	o := &Organisationsnummer{}
+func New(input string, options ...*Options) (*Organisationsnummer, error) {
+	opts := getOptions(options)
+	normalized, changes := opts.normalize(input)
 
-	if err := o.parse(input); err != nil {
-		return nil, err
+	number, p, f := parse(normalized, opts)
+	if f.reason != 0 {
+		return nil, originalError(f.error(normalized), input, changes)
 	}
 
-	return o, nil
+	return newOrganisationsnummer(number, p, opts), nil
 }
 
-// parse Swedish organization numbers and set struct properpties or return a error.
-func (o *Organisationsnummer) parse(input string) error {
-	if len(input) < 10 || len(input) > 13 {
-		return ErrInvalidOrganizationNumber
+// newOrganisationsnummer returns a new struct for a parsed number.
+func newOrganisationsnummer(number [10]byte, p *personnummer.Personnummer, options *Options) *Organisationsnummer {
+	if p != nil {
+		return &Organisationsnummer{
+			number:       p.Year + p.Month + p.Day + p.Num + p.Check,
+			personnummer: p,
+			format:       options.TextFormat,
+		}
 	}
 
-	number := getCleanNumber(input)
-	p, err := personnummer.Parse(input)
+	return &Organisationsnummer{number: string(number[:]), format: options.TextFormat}
+}
 
-	if err == nil {
-		o.personnummer = p
-		o.number = string(number)
-		return nil
-	} else if len(number) == 12 {
-		// May only be prefixed with 16.
-		if charsToDigit(number[0:2]) != 16 {
-			return ErrInvalidOrganizationNumber
+// failure describes why parse rejected a input, it is only turned into
+// a *ParseError when needed so validation does not allocate.
+type failure struct {
+	reason Reason
+	offset int
+	err    error
+}
+
+// error returns the failure as a *ParseError.
+func (f failure) error(input string) error {
+	return &ParseError{Input: input, Reason: f.reason, Offset: f.offset, Err: f.err}
+}
+
+// digitsOf copies the digits of the input into a array without allocating.
+// n is the number of digits, which may be more than fits in the array, and
+// offset is the byte offset of the first illegal character or -1.
+func digitsOf[T text](input T) (digits [12]byte, n int, offset int) {
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		switch {
+		case c == '-' || c == '+':
+			continue
+		case c >= '0' && c <= '9':
+			if n < len(digits) {
+				digits[n] = c
+			}
+			n++
+		default:
+			return digits, n, i
 		}
+	}
 
-		number = number[2:]
+	return digits, n, -1
+}
+
+// parse Swedish organization numbers and returns the ten digits of a
+// organization number or the personnummer of a sole trader.
+//
+// Organization numbers has 20 or more as third and fourth digits, which
+// is never a valid month, so personnummer is only parsed for other inputs
+// and organization numbers can be validated without allocating.
+func parse[T text](input T, options *Options) (number [10]byte, p *personnummer.Personnummer, f failure) {
+	// Normalized input is measured by the number of digits only.
+	if options.Normalization == 0 && (len(input) < 10 || len(input) > 13) {
+		return number, nil, failure{ReasonLength, -1, nil}
 	}
 
-	if len(number) == 10 {
-		// Third digit bust be more than 20.
-		if charsToDigit(number[2:4]) < 20 {
-			return ErrInvalidOrganizationNumber
+	if options.StrictSeparators {
+		if offset := separatorOffset(input); offset >= 0 {
+			return number, nil, failure{ReasonSeparator, offset, nil}
 		}
+	}
 
-		// May not start with leading 0.
-		if charsToDigit(number[0:2]) < 10 {
-			return ErrInvalidOrganizationNumber
+	digits, n, offset := digitsOf(input)
+
+	var err error
+
+	// Interim numbers contains letters and can only be personnummer.
+	if offset >= 0 && !options.AllowInterimNumber {
+		return number, nil, failure{ReasonIllegalCharacter, offset, nil}
+	}
+
+	if offset >= 0 || ((n == 10 || n == 12) && digits[n-8] < '2') {
+		p, err = personnummer.Parse(string(input), options.personnummerOptions())
+
+		switch {
+		case err == nil && options.DisablePersonnummer:
+			return number, nil, failure{ReasonPersonnummer, -1, nil}
+		case err == nil && !options.allowsType(SoleTrader):
+			return number, nil, failure{ReasonType, -1, nil}
+		case err == nil:
+			return number, p, failure{}
+		case offset >= 0:
+			return number, nil, failure{ReasonIllegalCharacter, offset, nil}
+		case options.DisableCoordinationNumber && personnummer.Valid(string(input)):
+			// Rejected only because of the options.
+			return number, nil, failure{ReasonPersonnummer, -1, err}
 		}
+	}
 
-		if !luhn(number) {
-			return ErrInvalidOrganizationNumber
+	// Plus is the personnummer separator for people 100 years or older.
+	if options.StrictSeparators {
+		if offset := indexByte(input, '+'); offset >= 0 {
+			return number, nil, failure{ReasonSeparator, offset, nil}
 		}
+	}
+
+	// skip is the number of prefix digits, used to report offsets.
+	skip := 0
+
+	switch n {
+	case 12:
+		// May only be prefixed with 16, other centuries are personnummer.
+		switch {
+		case digits[0] == '1' && digits[1] == '6':
+			if options.DisableCenturyPrefix {
+				return number, nil, failure{ReasonPrefix, digitOffset(input, 0), nil}
+			}
+		case err != nil && charsToDigit(digits[0:2]) >= 18 && charsToDigit(digits[0:2]) <= 20:
+			return number, nil, failure{ReasonPersonnummer, -1, err}
+		default:
+			return number, nil, failure{ReasonPrefix, digitOffset(input, 0), nil}
+		}
+
+		skip = 2
+	case 10:
+	default:
+		return number, nil, failure{ReasonLength, -1, nil}
+	}
+
+	copy(number[:], digits[skip:n])
 
-		o.number = string(number)
-	} else {
-		return err
+	// Third digit bust be more than 20.
+	if number[2] < '2' {
+		return number, nil, failure{ReasonGroupDigits, digitOffset(input, skip+2), nil}
 	}
 
-	return nil
+	// May not start with leading 0.
+	if number[0] == '0' {
+		return number, nil, failure{ReasonLeadingZero, digitOffset(input, skip), nil}
+	}
+
+	if !luhn(number[:]) {
+		return number, nil, failure{ReasonChecksum, digitOffset(input, skip+9), nil}
+	}
+
+	if !options.allowsType(typeFromGroup(int(number[0] - '0'))) {
+		return number, nil, failure{ReasonType, digitOffset(input, skip), nil}
+	}
+
+	return number, nil, failure{}
 }
 
-// Get Personnummer instance
+// Get Personnummer instance, the zero value is returned for organization
+// numbers that are not a personnummer, see PersonnummerOK.
 func (o *Organisationsnummer) Personnummer() personnummer.Personnummer {
-	return *o.personnummer
+	p, _ := o.PersonnummerOK()
+	return p
+}
+
+// PersonnummerOK returns the personnummer of a sole trader and true, or
+// the zero value and false for other organization numbers.
+func (o *Organisationsnummer) PersonnummerOK() (personnummer.Personnummer, bool) {
+	if !o.IsPersonnummer() {
+		return personnummer.Personnummer{}, false
+	}
+
+	return *o.personnummer, true
 }
 
 // Determine if personnummer or not.
 func (o *Organisationsnummer) IsPersonnummer() bool {
-	return o.personnummer != nil
+	return o != nil && o.personnummer != nil
+}
+
+// IsZero determine if the organization number is the zero value or nil,
+// the zero value formats as a empty string and has the type Unknown. Struct
+// fields tagged with omitzero are omitted by encoding/json in Go 1.24 or
+// later when zero.
+func (o *Organisationsnummer) IsZero() bool {
+	return o == nil || o.number == ""
 }
 
 // Format a Swedish organization number as one of the official formats,
-// a long format or a short format.
+// with a separator or as short format, see FormatAs for other formats.
 func (o *Organisationsnummer) Format(separator ...bool) string {
-	var number = o.number
-
-	if o.IsPersonnummer() {
-		if len(separator) > 0 && separator[0] {
-			f, _ := o.personnummer.Format(false)
-			return f
-		}
-
-		f, _ := o.personnummer.Format(true)
-		return f[2:]
-	}
-
 	if len(separator) > 0 && separator[0] {
-		return fmt.Sprintf("%s-%s", number[0:6], number[6:])
+		return o.FormatAs(FormatHyphen)
 	}
 
-	return number
+	return o.FormatAs(FormatShort)
 }
 
-// Get the organization type.
+// Get the organization type, kept for compatibility, use Type instead.
 func (o *Organisationsnummer) GetType() string {
-	if o.IsPersonnummer() {
-		return "Enskild firma"
+	if o.IsZero() {
+		return ""
 	}
 
-	if types[o.number[0]] != "" {
-		return types[o.number[0]]
-	}
-
-	return unknown
+	return o.Type().Label()
 }
 
 // Get the organization type.
@@ -194,16 +355,49 @@ func (o *Organisationsnummer) String() string {
 
 // Get vat number for a organization number.
 func (o *Organisationsnummer) VatNumber() string {
-	return fmt.Sprintf("SE%s01", o.Format(false))
+	var buf [16]byte
+	return string(o.AppendVatNumber(buf[:0]))
 }
 
-// Valid will validate Swedish organization numbers
-func Valid(input string) bool {
-	_, err := Parse(input)
-	return err == nil
+// Valid will validate Swedish organization numbers, organization numbers
+// are validated without allocating.
+func Valid(input string, options ...*Options) bool {
+	opts := getOptions(options)
+	normalized, _ := opts.normalize(input)
+
+	_, _, f := parse(normalized, opts)
+	return f.reason == 0
 }
 
 // Parse Swedish organization numbers and return a new struct.
-func Parse(input string) (*Organisationsnummer, error) {
-	return New(input)
+func Parse(input string, options ...*Options) (*Organisationsnummer, error) {
+	return New(input, options...)
+}
+
+// ParseBytes parse Swedish organization numbers from a byte slice without
+// copying it, except for personnummer and input that is normalized.
+func ParseBytes(input []byte, options ...*Options) (*Organisationsnummer, error) {
+	opts := getOptions(options)
+	if opts.Normalization != 0 && !isCleanInput(input) {
+		return New(string(input), opts)
+	}
+
+	number, p, f := parse(input, opts)
+	if f.reason != 0 {
+		return nil, f.error(string(input))
+	}
+
+	return newOrganisationsnummer(number, p, opts), nil
+}
+
+// ValidBytes will validate Swedish organization numbers in a byte slice,
+// organization numbers are validated without allocating.
+func ValidBytes(input []byte, options ...*Options) bool {
+	opts := getOptions(options)
+	if opts.Normalization != 0 && !isCleanInput(input) {
+		return Valid(string(input), opts)
+	}
+
+	_, _, f := parse(input, opts)
+	return f.reason == 0
 }
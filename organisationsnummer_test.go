@@ -7,6 +7,7 @@ import (
 
 	"github.com/frozzare/go-assert"
 	"github.com/frozzare/go/http2"
+	personnummer "github.com/personnummer/go/v3"
 )
 
 type TestListItem struct {
@@ -112,3 +113,78 @@ func TestValidPersonnummer(t *testing.T) {
 		assert.Equal(t, org.Format(false), item.ShortFormat)
 	}
 }
+
+func TestValidAllocations(t *testing.T) {
+	for _, input := range []string{"556016-0680", "165560160680", "556016-0681"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			Valid(input)
+		})
+
+		assert.Equal(t, float64(0), allocs, input)
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	inputs := []string{
+		"556016-0680",
+		"165560160680",
+		"121212-1212",
+		"19121212+1212",
+		"556016-0681",
+		"556016-068a",
+		"556016",
+		" 556016–0680 ",
+	}
+
+	for _, options := range []*Options{nil, {StrictSeparators: true}, {Normalization: NormalizeAll}} {
+		for _, input := range inputs {
+			expected, expectedErr := Parse(input, options)
+			o, err := ParseBytes([]byte(input), options)
+
+			assert.Equal(t, expectedErr, err, input)
+			assert.Equal(t, expected, o, input)
+			assert.Equal(t, Valid(input, options), ValidBytes([]byte(input), options), input)
+		}
+	}
+
+	input := []byte("556016-0680")
+	allocs := testing.AllocsPerRun(100, func() {
+		ValidBytes(input)
+	})
+
+	assert.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkValid(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Valid("556016-0680")
+	}
+}
+
+func BenchmarkValidPersonnummer(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Valid("121212-1212")
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Parse("556016-0680")
+	}
+}
+
+// BenchmarkPersonnummerParse is the cost every organization number paid
+// when personnummer was always parsed first.
+func BenchmarkPersonnummerParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		personnummer.Parse("556016-0680")
+	}
+}
@@ -0,0 +1,138 @@
+// Package organisationsnummertest provides helpers for testing code that
+// validates Swedish organization numbers.
+package organisationsnummertest
+
+import (
+	"errors"
+	"testing"
+
+	organisationsnummer "github.com/organisationsnummer/go"
+)
+
+// Variant is a invalid variant of a valid organization number.
+type Variant struct {
+	// Name describes how the number was changed.
+	Name string
+	// Input is the invalid number.
+	Input string
+	// Reason is the reason parsing Input fails with.
+	Reason organisationsnummer.Reason
+}
+
+// checkDigit returns the luhn check digit for the first nine digits.
+func checkDigit(number []byte) byte {
+	digit, err := organisationsnummer.CalculateCheckDigit(string(number[:9]))
+	if err != nil {
+		panic(err)
+	}
+
+	return byte(digit) + '0'
+}
+
+// hyphen formats ten digits with a separator.
+func hyphen(number []byte) string {
+	return string(number[:6]) + "-" + string(number[6:])
+}
+
+// reason returns the reason input fails to parse with, or 0.
+func reason(input string) organisationsnummer.Reason {
+	var perr *organisationsnummer.ParseError
+	if _, err := organisationsnummer.Parse(input); errors.As(err, &perr) {
+		return perr.Reason
+	}
+
+	return 0
+}
+
+// Invalid returns invalid variants of a valid organization number, each
+// labelled with the reason it fails with. Sole traders, nil and the zero
+// value have no variants.
+func Invalid(o *organisationsnummer.Organisationsnummer) []Variant {
+	if o.IsPersonnummer() || o.IsZero() {
+		return nil
+	}
+
+	short := o.Format(false)
+
+	var variants []Variant
+
+	number := []byte(short)
+	number[9] = (number[9]-'0'+1)%10 + '0'
+	variants = append(variants, Variant{"wrong check digit", hyphen(number), organisationsnummer.ReasonChecksum})
+
+	// Transpositions of 0 and 9 are not detected by luhn.
+	for i := 4; i < 9; i++ {
+		number = []byte(short)
+		number[i], number[i+1] = number[i+1], number[i]
+
+		if input := hyphen(number); reason(input) == organisationsnummer.ReasonChecksum {
+			variants = append(variants, Variant{"adjacent transposition", input, organisationsnummer.ReasonChecksum})
+			break
+		}
+	}
+
+	number = []byte(short)
+	number[0] = '0'
+	number[9] = checkDigit(number)
+	variants = append(variants, Variant{"leading zero", hyphen(number), organisationsnummer.ReasonLeadingZero})
+
+	// 19 is not a valid month so it can not be mistaken for a personnummer.
+	number = []byte(short)
+	number[2], number[3] = '1', '9'
+	number[9] = checkDigit(number)
+	variants = append(variants, Variant{"group digits below 20", hyphen(number), organisationsnummer.ReasonGroupDigits})
+
+	variants = append(variants,
+		Variant{"bad 16 prefix", "17" + short, organisationsnummer.ReasonPrefix},
+		Variant{"illegal character", short[:6] + "-" + short[6:8] + "A" + short[9:], organisationsnummer.ReasonIllegalCharacter},
+		Variant{"too short", short[:9], organisationsnummer.ReasonLength},
+		Variant{"too long", short + "0", organisationsnummer.ReasonLength},
+	)
+
+	return variants
+}
+
+// AssertValid fails the test and stops it if input is not a valid
+// organization number, so the returned number is never nil.
+func AssertValid(t testing.TB, input string, options ...*organisationsnummer.Options) *organisationsnummer.Organisationsnummer {
+	t.Helper()
+
+	o, err := organisationsnummer.Parse(input, options...)
+	if err != nil {
+		t.Fatalf("expected %q to be valid: %v", input, err)
+	}
+
+	return o
+}
+
+// AssertInvalid fails the test unless parsing input fails with reason.
+func AssertInvalid(t testing.TB, input string, reason organisationsnummer.Reason, options ...*organisationsnummer.Options) {
+	t.Helper()
+
+	_, err := organisationsnummer.Parse(input, options...)
+	if err == nil {
+		t.Errorf("expected %q to be invalid: %s", input, reason)
+		return
+	}
+
+	var perr *organisationsnummer.ParseError
+	if !errors.As(err, &perr) {
+		t.Errorf("expected %q to fail with *ParseError, got %T: %v", input, err, err)
+		return
+	}
+
+	if perr.Reason != reason {
+		t.Errorf("expected %q to be invalid: %s, got %s", input, reason, perr.Reason)
+	}
+}
+
+// AssertRejects fails the test if valid accepts any invalid variant of o.
+func AssertRejects(t testing.TB, o *organisationsnummer.Organisationsnummer, valid func(string) bool) {
+	t.Helper()
+
+	for _, v := range Invalid(o) {
+		if valid(v.Input) {
+			t.Errorf("expected %q (%s) to be rejected", v.Input, v.Name)
+		}
+	}
+}
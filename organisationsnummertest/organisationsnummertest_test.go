@@ -0,0 +1,86 @@
+package organisationsnummertest
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+	organisationsnummer "github.com/organisationsnummer/go"
+)
+
+func TestInvalid(t *testing.T) {
+	o := AssertValid(t, "556016-0680")
+	variants := Invalid(o)
+
+	names := make([]string, 0, len(variants))
+	for _, v := range variants {
+		names = append(names, v.Name)
+		AssertInvalid(t, v.Input, v.Reason)
+	}
+
+	assert.Equal(t, []string{
+		"wrong check digit",
+		"adjacent transposition",
+		"leading zero",
+		"group digits below 20",
+		"bad 16 prefix",
+		"illegal character",
+		"too short",
+		"too long",
+	}, names)
+
+	AssertRejects(t, o, func(input string) bool {
+		return organisationsnummer.Valid(input)
+	})
+}
+
+func TestInvalidGenerated(t *testing.T) {
+	g := organisationsnummer.NewGenerator(rand.NewPCG(1, 2))
+
+	for i := 0; i < 1000; i++ {
+		for _, v := range Invalid(g.Next()) {
+			AssertInvalid(t, v.Input, v.Reason)
+		}
+	}
+}
+
+func TestInvalidSoleTrader(t *testing.T) {
+	o := AssertValid(t, "121212-1212")
+	assert.Nil(t, Invalid(o))
+}
+
+func TestInvalidZero(t *testing.T) {
+	assert.Nil(t, Invalid(nil))
+	assert.Nil(t, Invalid(&organisationsnummer.Organisationsnummer{}))
+}
+
+// fatalRecorder records calls to Fatalf and stops the goroutine like
+// testing.T does.
+type fatalRecorder struct {
+	testing.TB
+	fatal bool
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(format string, args ...any) {
+	r.fatal = true
+	runtime.Goexit()
+}
+
+func TestAssertValidStops(t *testing.T) {
+	r := &fatalRecorder{TB: t}
+	returned := false
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		AssertValid(r, "556016-0681")
+		returned = true
+	}()
+
+	<-done
+	assert.True(t, r.fatal)
+	assert.False(t, returned)
+}
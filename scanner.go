@@ -0,0 +1,133 @@
+package organisationsnummer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+var (
+	newline        = []byte{'\n'}
+	carriageReturn = []byte{'\r'}
+)
+
+// Record is a single record read by a Scanner.
+type Record struct {
+	// Line is the 1-based line number the record starts on.
+	Line int
+	// Text is the raw text of the record.
+	Text string
+	// Number is the organization number, nil if Err is set.
+	Number *Organisationsnummer
+	// Err is the parse error of the record.
+	Err error
+}
+
+// Scanner reads organization numbers from a io.Reader, one record at a time
+// in constant memory. Records are split by lines by default.
+type Scanner struct {
+	scanner *bufio.Scanner
+	options *Options
+	record  Record
+	split   bufio.SplitFunc
+	// line is the line number of the next unread byte and tokenLine the
+	// line number of the most recent token.
+	line      int
+	tokenLine int
+}
+
+// NewScanner returns a new Scanner reading from r.
+func NewScanner(r io.Reader, options ...*Options) *Scanner {
+	s := &Scanner{
+		scanner: bufio.NewScanner(r),
+		options: getOptions(options),
+		split:   bufio.ScanLines,
+		line:    1,
+	}
+
+	s.scanner.Split(s.splitLines)
+
+	return s
+}
+
+// Split sets the split function of the Scanner, see bufio.Scanner.Split.
+func (s *Scanner) Split(split bufio.SplitFunc) {
+	// Panics like bufio.Scanner if called after scanning has started.
+	s.scanner.Split(s.splitLines)
+	s.split = split
+}
+
+// splitLines calls the split function and counts the newlines consumed,
+// so records have a line number regardless of how they are split.
+func (s *Scanner) splitLines(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := s.split(data, atEOF)
+	if advance < 0 || advance > len(data) {
+		return advance, token, err
+	}
+
+	if token != nil {
+		// Split functions may skip leading bytes, like bufio.ScanWords.
+		start := 0
+		if i := cap(data) - cap(token); len(token) > 0 && i >= 0 && i < advance && &data[i] == &token[0] {
+			start = i
+		}
+
+		s.tokenLine = s.line + bytes.Count(data[:start], newline)
+	}
+
+	s.line += bytes.Count(data[:advance], newline)
+
+	return advance, token, err
+}
+
+// Buffer sets the buffer of the Scanner, see bufio.Scanner.Buffer.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// Scan advances to the next record, which is available through Record.
+// It returns false when there are no more records or a read error occurs.
+func (s *Scanner) Scan() bool {
+	if !s.scanner.Scan() {
+		s.record = Record{Line: s.record.Line}
+		return false
+	}
+
+	s.record.Line = s.tokenLine
+	s.record.Text = s.scanner.Text()
+	s.record.Number, s.record.Err = Parse(s.record.Text, s.options)
+
+	return true
+}
+
+// Record returns the most recent record read by Scan.
+func (s *Scanner) Record() Record {
+	return s.record
+}
+
+// Err returns the first read error, parse errors are returned by Record.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// ScanDelimited returns a split function for records separated by delim,
+// like ';' or ','. Newlines are also treated as separators.
+func ScanDelimited(delim byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		for i, c := range data {
+			if c == delim || c == '\n' {
+				return i + 1, bytes.TrimSuffix(data[:i], carriageReturn), nil
+			}
+		}
+
+		if atEOF {
+			return len(data), bytes.TrimSuffix(data, carriageReturn), nil
+		}
+
+		return 0, nil, nil
+	}
+}
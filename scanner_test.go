@@ -0,0 +1,107 @@
+package organisationsnummer
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestScanner(t *testing.T) {
+	s := NewScanner(strings.NewReader("556016-0680\r\n556016-0681\n\n121212-1212\n"))
+
+	var records []Record
+	for s.Scan() {
+		records = append(records, s.Record())
+	}
+
+	assert.Nil(t, s.Err())
+	assert.Equal(t, 4, len(records))
+
+	for i, r := range records {
+		assert.Equal(t, i+1, r.Line)
+	}
+
+	assert.Equal(t, "556016-0680", records[0].Text)
+	assert.Equal(t, "5560160680", records[0].Number.Format(false))
+	assert.Nil(t, records[0].Err)
+	assertReason(t, records[1].Err, ReasonChecksum)
+	assert.Nil(t, records[1].Number)
+	assertReason(t, records[2].Err, ReasonLength)
+	assert.True(t, records[3].Number.IsPersonnummer())
+}
+
+func TestScannerOptions(t *testing.T) {
+	s := NewScanner(strings.NewReader(" 556016–0680 \n121212-1212"), &Options{
+		Normalization:       NormalizeAll,
+		DisablePersonnummer: true,
+	})
+
+	assert.True(t, s.Scan())
+	assert.Nil(t, s.Record().Err)
+	assert.True(t, s.Scan())
+	assertReason(t, s.Record().Err, ReasonPersonnummer)
+	assert.False(t, s.Scan())
+}
+
+func TestScannerDelimited(t *testing.T) {
+	s := NewScanner(strings.NewReader("556016-0680;202100-5489\n702001-7781"))
+	s.Split(ScanDelimited(';'))
+
+	var numbers []string
+	var lines []int
+	for s.Scan() {
+		assert.Nil(t, s.Record().Err)
+		numbers = append(numbers, s.Record().Number.Format(false))
+		lines = append(lines, s.Record().Line)
+	}
+
+	assert.Equal(t, []string{"5560160680", "2021005489", "7020017781"}, numbers)
+	assert.Equal(t, []int{1, 1, 2}, lines)
+
+	s = NewScanner(strings.NewReader("556016-0680\xff202100-5489\xfe"))
+	s.Split(ScanDelimited(0xff))
+
+	var texts []string
+	for s.Scan() {
+		texts = append(texts, s.Record().Text)
+	}
+
+	assert.Equal(t, []string{"556016-0680", "202100-5489\xfe"}, texts)
+
+	split := ScanDelimited(';')
+	data := []byte("556016-0680;202100-5489")
+	allocs := testing.AllocsPerRun(100, func() {
+		split(data, false)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestScannerWords(t *testing.T) {
+	s := NewScanner(strings.NewReader("\n\n  556016-0680 202100-5489\n\n702001-7781\n"))
+	s.Split(bufio.ScanWords)
+
+	var lines []int
+	for s.Scan() {
+		lines = append(lines, s.Record().Line)
+	}
+
+	assert.Equal(t, []int{3, 3, 5}, lines)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func TestScannerReadError(t *testing.T) {
+	s := NewScanner(io.MultiReader(strings.NewReader("556016-0680\n"), errReader{}))
+
+	assert.True(t, s.Scan())
+	assert.False(t, s.Scan())
+	assert.True(t, errors.Is(s.Err(), io.ErrUnexpectedEOF))
+}
@@ -0,0 +1,84 @@
+package organisationsnummer
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Scan implements sql.Scanner, text columns in any parseable format and
+// integer columns are accepted and validated. Empty text is rejected, the
+// zero value is stored as NULL.
+func (o *Organisationsnummer) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return o.Scan([]byte(v))
+	case []byte:
+		if len(v) == 0 {
+			return newParseError("", ReasonLength, -1)
+		}
+
+		return o.UnmarshalText(v)
+	case int64:
+		if v < 0 {
+			return newParseError(strconv.FormatInt(v, 10), ReasonIllegalCharacter, 0)
+		}
+
+		// Personnummer for people born 2000-2009 starts with 0.
+		return o.UnmarshalText(fmt.Appendf(nil, "%010d", v))
+	case nil:
+		return fmt.Errorf("organisationsnummer: cannot scan NULL, use NullOrganisationsnummer")
+	default:
+		return fmt.Errorf("organisationsnummer: cannot scan %T", src)
+	}
+}
+
+// Value implements driver.Valuer, the number is stored as text in one
+// canonical format regardless of the TextFormat option, ten digits for
+// organization numbers and twelve digits for sole traders so the century
+// is kept. The zero value is stored as NULL.
+func (o Organisationsnummer) Value() (driver.Value, error) {
+	if o.IsZero() {
+		return nil, nil
+	}
+
+	if o.IsPersonnummer() {
+		return o.FormatAs(FormatLong), nil
+	}
+
+	return o.FormatAs(FormatShort), nil
+}
+
+// NullOrganisationsnummer represents a organization number that may be
+// NULL, like sql.NullString.
+type NullOrganisationsnummer struct {
+	Organisationsnummer Organisationsnummer
+	// Valid is true if Organisationsnummer is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner.
+func (n *NullOrganisationsnummer) Scan(src any) error {
+	if src == nil {
+		n.Organisationsnummer, n.Valid = Organisationsnummer{}, false
+		return nil
+	}
+
+	if err := n.Organisationsnummer.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullOrganisationsnummer) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Organisationsnummer.Value()
+}
@@ -0,0 +1,98 @@
+package organisationsnummer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+var (
+	_ sql.Scanner   = &Organisationsnummer{}
+	_ driver.Valuer = Organisationsnummer{}
+	_ sql.Scanner   = &NullOrganisationsnummer{}
+	_ driver.Valuer = NullOrganisationsnummer{}
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		src      any
+		expected string
+	}{
+		{"5560160680", "5560160680"},
+		{"556016-0680", "5560160680"},
+		{"165560160680", "5560160680"},
+		{[]byte("556016-0680"), "5560160680"},
+		{int64(5560160680), "5560160680"},
+		{int64(165560160680), "5560160680"},
+		{int64(191212121212), "1212121212"},
+		{int64(101010015), "0101010015"},
+	}
+
+	for _, test := range tests {
+		var o Organisationsnummer
+		assert.Nil(t, o.Scan(test.src), test.src)
+		assert.Equal(t, test.expected, o.Format(false), test.src)
+	}
+
+	var o Organisationsnummer
+	assertReason(t, o.Scan("556016-0681"), ReasonChecksum)
+	assertReason(t, o.Scan(int64(-5560160680)), ReasonIllegalCharacter)
+	assert.NotNil(t, o.Scan(nil))
+	assert.NotNil(t, o.Scan(1.5))
+}
+
+func TestValue(t *testing.T) {
+	o, _ := Parse("16556016-0680")
+	v, err := o.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "5560160680", v)
+
+	for _, style := range []FormatStyle{FormatHyphen, FormatLong, FormatVat} {
+		o, _ = Parse("5560160680", &Options{TextFormat: style})
+		v, _ = o.Value()
+		assert.Equal(t, "5560160680", v, style)
+	}
+
+	o, _ = Parse("121212+1212", &Options{TextFormat: FormatHyphen})
+	v, _ = o.Value()
+	assert.Equal(t, "191212121212", v)
+}
+
+func TestValueScanRoundTrip(t *testing.T) {
+	inputs := []string{"556016-0680", "16202100-5489", "121212+1212", "19121212-1212", "000101-T220", "701063-2391"}
+	styles := []FormatStyle{FormatShort, FormatHyphen, FormatLong, FormatLongHyphen, FormatVat, FormatVatSpaced, FormatPersonnummer}
+
+	for _, input := range inputs {
+		for _, style := range styles {
+			o, err := Parse(input, &Options{AllowInterimNumber: true, TextFormat: style})
+			assert.Nil(t, err, input)
+
+			v, err := o.Value()
+			assert.Nil(t, err, input, style)
+
+			var n Organisationsnummer
+			assert.Nil(t, n.Scan(v), input, style)
+			assert.True(t, o.Equal(&n), input, style)
+		}
+	}
+}
+
+func TestNullOrganisationsnummer(t *testing.T) {
+	var n NullOrganisationsnummer
+
+	assert.Nil(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	v, err := n.Value()
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	assert.Nil(t, n.Scan("556016-0680"))
+	assert.True(t, n.Valid)
+	v, _ = n.Value()
+	assert.Equal(t, "5560160680", v)
+
+	assertReason(t, n.Scan("556016-0681"), ReasonChecksum)
+	assert.False(t, n.Valid)
+}
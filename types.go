@@ -0,0 +1,90 @@
+package organisationsnummer
+
+import (
+	"fmt"
+)
+
+// OrganizationType represents the type of organization, given by the
+// group number for organization numbers.
+type OrganizationType int
+
+const (
+	// Unknown is used for group numbers without a known type.
+	Unknown OrganizationType = iota
+	// Dodsbo is group 1, estates of deceased persons.
+	Dodsbo
+	// Stat is group 2, state, county councils, municipalities or parishes.
+	Stat
+	// UtlandsktForetag is group 3, foreign companies.
+	UtlandsktForetag
+	_
+	// Aktiebolag is group 5, limited companies.
+	Aktiebolag
+	// EnkeltBolag is group 6, simple partnerships.
+	EnkeltBolag
+	// EkonomiskForening is group 7, economic associations.
+	EkonomiskForening
+	// IdeellForening is group 8, non-profit associations and foundations.
+	IdeellForening
+	// Handelsbolag is group 9, trading and limited partnerships.
+	Handelsbolag
+	// SoleTrader is used for sole traders identified by a personnummer.
+	SoleTrader
+)
+
+// typeFromGroup returns the organization type of a group number.
+func typeFromGroup(group int) OrganizationType {
+	t := OrganizationType(group)
+	if t.Code() == 0 {
+		return Unknown
+	}
+
+	return t
+}
+
+// Code returns the group number of the type, or 0 for sole traders and
+// unknown types.
+func (t OrganizationType) Code() int {
+	if t < Dodsbo || t > Handelsbolag || t == 4 {
+		return 0
+	}
+
+	return int(t)
+}
+
+// Label returns the Swedish label of the type.
+func (t OrganizationType) Label() string {
+	return swedish.TypeLabel(t)
+}
+
+// EnglishLabel returns the English label of the type.
+func (t OrganizationType) EnglishLabel() string {
+	return english.TypeLabel(t)
+}
+
+// LocalizedLabel returns the label of the type in the given language.
+func (t OrganizationType) LocalizedLabel(lang string) string {
+	return GetLocalizer(lang).TypeLabel(t)
+}
+
+// String returns the Swedish label of the type.
+func (t OrganizationType) String() string {
+	if _, ok := swedish.Types[t]; !ok {
+		return fmt.Sprintf("OrganizationType(%d)", int(t))
+	}
+
+	return t.Label()
+}
+
+// Type returns the organization type, Unknown for the zero value.
+func (o *Organisationsnummer) Type() OrganizationType {
+	if o.IsPersonnummer() {
+		return SoleTrader
+	}
+
+	if o.IsZero() {
+		return Unknown
+	}
+
+	return typeFromGroup(int(o.number[0] - '0'))
+}
@@ -0,0 +1,41 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected OrganizationType
+		code     int
+	}{
+		{"556016-0680", Aktiebolag, 5},
+		{"202100-5489", Stat, 2},
+		{"702001-7781", EkonomiskForening, 7},
+		{"802002-4280", IdeellForening, 8},
+		{"121212-1212", SoleTrader, 0},
+		{"402000-0008", Unknown, 0},
+	}
+
+	for _, test := range tests {
+		o, err := Parse(test.input)
+		assert.Nil(t, err, test.input)
+		assert.Equal(t, test.expected, o.Type(), test.input)
+		assert.Equal(t, test.code, o.Type().Code(), test.input)
+		assert.Equal(t, o.Type().Label(), o.GetType(), test.input)
+	}
+}
+
+func TestTypeLabels(t *testing.T) {
+	assert.Equal(t, "Aktiebolag", Aktiebolag.Label())
+	assert.Equal(t, "Limited companies", Aktiebolag.EnglishLabel())
+	assert.Equal(t, "Enskild firma", SoleTrader.Label())
+	assert.Equal(t, "Sole trader", SoleTrader.EnglishLabel())
+	assert.Equal(t, "Okänt", Unknown.Label())
+	assert.Equal(t, "Unknown", Unknown.EnglishLabel())
+	assert.Equal(t, "Okänt", OrganizationType(4).Label())
+	assert.Equal(t, "OrganizationType(4)", OrganizationType(4).String())
+}
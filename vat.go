@@ -0,0 +1,162 @@
+package organisationsnummer
+
+import (
+	"strings"
+)
+
+// Vat represents a Swedish VAT number, a organization number together
+// with a two digit registration suffix.
+type Vat struct {
+	organisationsnummer *Organisationsnummer
+	suffix              string
+}
+
+// validVatSuffix determine if the suffix is two digits between 01 and 99.
+func validVatSuffix(suffix string) bool {
+	if len(suffix) != 2 || suffix == "00" {
+		return false
+	}
+
+	return suffix[0] >= '0' && suffix[0] <= '9' && suffix[1] >= '0' && suffix[1] <= '9'
+}
+
+// ParseVat parse a Swedish VAT number, like SE556016058101, and keeps
+// the registration suffix.
+func ParseVat(input string, options ...*Options) (*Vat, error) {
+	opts := getOptions(options)
+
+	normalized, changes := opts.normalize(input)
+
+	v, err := parseVat(normalized, opts)
+	if err != nil {
+		return nil, originalError(err, input, changes)
+	}
+
+	return v, nil
+}
+
+// ParseVatNumber parse a Swedish VAT number, like SE556016058101, and
+// returns the organization number it was issued for.
+func ParseVatNumber(input string, options ...*Options) (*Organisationsnummer, error) {
+	v, err := ParseVat(input, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.organisationsnummer, nil
+}
+
+// parseVat parse a normalized Swedish VAT number.
+func parseVat(input string, options *Options) (*Vat, error) {
+	if len(input) < 2 || !strings.EqualFold(input[:2], "SE") {
+		return nil, newParseError(input, ReasonCountryCode, 0)
+	}
+
+	var digits [12]byte
+	var offsets [12]int
+	n := 0
+
+	for i := 2; i < len(input); i++ {
+		c := input[i]
+
+		switch {
+		case c == ' ':
+			continue
+		case c >= '0' && c <= '9':
+			if n == len(digits) {
+				return nil, newParseError(input, ReasonLength, -1)
+			}
+
+			digits[n] = c
+			offsets[n] = i
+			n++
+		default:
+			return nil, newParseError(input, ReasonIllegalCharacter, i)
+		}
+	}
+
+	if n != len(digits) {
+		return nil, newParseError(input, ReasonLength, -1)
+	}
+
+	suffix := string(digits[10:])
+	if !validVatSuffix(suffix) {
+		return nil, newParseError(input, ReasonVatSuffix, offsets[10])
+	}
+
+	o, err := New(string(digits[:10]), options)
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = input
+			if perr.Offset >= 0 {
+				perr.Offset = offsets[perr.Offset]
+			}
+		}
+
+		return nil, err
+	}
+
+	return &Vat{organisationsnummer: o, suffix: suffix}, nil
+}
+
+// Organisationsnummer returns the organization number of the VAT number.
+func (v *Vat) Organisationsnummer() *Organisationsnummer {
+	return v.organisationsnummer
+}
+
+// Suffix returns the two digit registration suffix.
+func (v *Vat) Suffix() string {
+	return v.suffix
+}
+
+// String returns the VAT number.
+func (v *Vat) String() string {
+	var buf [16]byte
+	return string(v.AppendVatNumber(buf[:0]))
+}
+
+// AppendVatNumber appends the VAT number to dst and returns the extended
+// buffer, nothing is appended for the zero value.
+func (v *Vat) AppendVatNumber(dst []byte) []byte {
+	if v.organisationsnummer.IsZero() {
+		return dst
+	}
+
+	dst = append(dst, "SE"...)
+	dst = v.organisationsnummer.AppendFormat(dst, FormatShort)
+	return append(dst, v.suffix...)
+}
+
+// Matches determine if the VAT number was issued for the given
+// organization number.
+func (v *Vat) Matches(o *Organisationsnummer) bool {
+	if o.IsZero() || v.organisationsnummer.IsZero() {
+		return false
+	}
+
+	return v.organisationsnummer.IsPersonnummer() == o.IsPersonnummer() &&
+		v.organisationsnummer.Format(false) == o.Format(false)
+}
+
+// VatNumberWithSuffix returns the VAT number for a organization number
+// with the given registration suffix.
+func (o *Organisationsnummer) VatNumberWithSuffix(suffix string) (string, error) {
+	if !validVatSuffix(suffix) {
+		return "", newParseError(suffix, ReasonVatSuffix, 0)
+	}
+
+	v := Vat{organisationsnummer: o, suffix: suffix}
+
+	return v.String(), nil
+}
+
+// MatchesVatNumber determine if the VAT number was issued for the
+// organization number.
+func (o *Organisationsnummer) MatchesVatNumber(input string, options ...*Options) bool {
+	v, err := ParseVat(input, options...)
+	if err != nil {
+		return false
+	}
+
+	return v.Matches(o)
+}
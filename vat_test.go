@@ -0,0 +1,87 @@
+package organisationsnummer
+
+import (
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestParseVatNumber(t *testing.T) {
+	for _, input := range []string{
+		"SE556016068001",
+		"SE 5560160680 01",
+		"se556016068001",
+		"Se 556016068001",
+	} {
+		o, err := ParseVatNumber(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, "5560160680", o.Format(false), input)
+	}
+
+	o, err := ParseVatNumber("SE121212121201")
+	assert.Nil(t, err)
+	assert.True(t, o.IsPersonnummer())
+	assert.Equal(t, "SE121212121201", o.VatNumber())
+
+	o, err = ParseVatNumber(" SE 5560160680 01 ", &Options{Normalization: NormalizeAll})
+	assert.Nil(t, err)
+	assert.Equal(t, "SE556016068001", o.VatNumber())
+}
+
+func TestParseVatNumberInvalid(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason Reason
+		offset int
+	}{
+		{"NO556016068001", ReasonCountryCode, 0},
+		{"5560160680", ReasonCountryCode, 0},
+		{"SE5560160680", ReasonLength, -1},
+		{"SE55601606800101", ReasonLength, -1},
+		{"SE556016-068001", ReasonIllegalCharacter, 8},
+		{"SE556016068000", ReasonVatSuffix, 12},
+		{"SE 5560160681 01", ReasonChecksum, 12},
+	}
+
+	for _, test := range tests {
+		_, err := ParseVatNumber(test.input)
+		assertReason(t, err, test.reason, test.input)
+		assert.Equal(t, test.offset, err.(*ParseError).Offset, test.input)
+		assert.Equal(t, test.input, err.(*ParseError).Input, test.input)
+	}
+}
+
+func TestParseVat(t *testing.T) {
+	v, err := ParseVat("SE556016068002")
+	assert.Nil(t, err)
+	assert.Equal(t, "02", v.Suffix())
+	assert.Equal(t, "SE556016068002", v.String())
+	assert.Equal(t, "5560160680", v.Organisationsnummer().Format(false))
+
+	o, _ := Parse("556016-0680")
+	assert.True(t, v.Matches(o))
+	assert.True(t, o.MatchesVatNumber("SE556016068001"))
+	assert.True(t, o.MatchesVatNumber("SE 5560160680 12"))
+	assert.False(t, o.MatchesVatNumber("SE202100548901"))
+	assert.False(t, o.MatchesVatNumber("SE556016068000"))
+
+	o, _ = Parse("202100-5489")
+	assert.False(t, v.Matches(o))
+}
+
+func TestVatNumberWithSuffix(t *testing.T) {
+	o, _ := Parse("556016-0680")
+
+	vat, err := o.VatNumberWithSuffix("02")
+	assert.Nil(t, err)
+	assert.Equal(t, "SE556016068002", vat)
+
+	vat, err = o.VatNumberWithSuffix("01")
+	assert.Nil(t, err)
+	assert.Equal(t, o.VatNumber(), vat)
+
+	for _, suffix := range []string{"", "00", "1", "001", "a1"} {
+		_, err = o.VatNumberWithSuffix(suffix)
+		assertReason(t, err, ReasonVatSuffix, suffix)
+	}
+}
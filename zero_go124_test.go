@@ -0,0 +1,29 @@
+//go:build go1.24
+
+package organisationsnummer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+)
+
+func TestZeroValueOmitZero(t *testing.T) {
+	var o Organisationsnummer
+
+	data, err := json.Marshal(struct {
+		Number   Organisationsnummer  `json:"number,omitzero"`
+		Optional *Organisationsnummer `json:"optional,omitzero"`
+		Empty    *Organisationsnummer `json:"empty,omitzero"`
+	}{Optional: &o})
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	n, _ := Parse("556016-0680")
+	data, err = json.Marshal(struct {
+		Number Organisationsnummer `json:"number,omitzero"`
+	}{Number: *n})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"number":"5560160680"}`, string(data))
+}
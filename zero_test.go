@@ -0,0 +1,114 @@
+package organisationsnummer
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/frozzare/go-assert"
+	personnummer "github.com/personnummer/go/v3"
+)
+
+func TestZeroValue(t *testing.T) {
+	var o Organisationsnummer
+
+	assert.True(t, o.IsZero())
+	assert.False(t, o.IsPersonnummer())
+	assert.Equal(t, "", o.Format(false))
+	assert.Equal(t, "", o.Format(true))
+	assert.Equal(t, "", o.FormatAs(FormatLongHyphen))
+	assert.Equal(t, "", o.FormatAs(FormatVatSpaced))
+	assert.Equal(t, "", o.VatNumber())
+	assert.Equal(t, "", o.GetType())
+	assert.Equal(t, "", o.String())
+	assert.Equal(t, Unknown, o.Type())
+	assert.Equal(t, 0, o.GroupNumber())
+	assert.Equal(t, 0, o.ThirdDigit())
+	assert.Equal(t, "", o.SerialNumber())
+	assert.Equal(t, 0, o.CheckDigit())
+	assert.Equal(t, uint64(0), o.Uint64())
+	assert.Equal(t, personnummer.Personnummer{}, o.Personnummer())
+	assert.False(t, o.MatchesVatNumber("SE556016068001"))
+
+	vat, err := o.VatNumberWithSuffix("01")
+	assert.Nil(t, err)
+	assert.Equal(t, "", vat)
+
+	var v Vat
+	assert.Equal(t, "", v.String())
+	assert.False(t, v.Matches(&o))
+
+	var p *Organisationsnummer
+	assert.True(t, p.IsZero())
+	assert.False(t, p.IsPersonnummer())
+	assert.Equal(t, "", p.Format(false))
+}
+
+func TestZeroValueEncoding(t *testing.T) {
+	var o Organisationsnummer
+
+	data, err := json.Marshal(encodingTest{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"number":null,"optional":null}`, string(data))
+
+	var v encodingTest
+	assert.Nil(t, json.Unmarshal(data, &v))
+	assert.True(t, v.Number.IsZero())
+
+	text, err := o.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "", string(text))
+
+	n, _ := Parse("556016-0680", &Options{TextFormat: FormatHyphen})
+	assert.Nil(t, n.UnmarshalText(text))
+	assert.True(t, n.IsZero())
+	assert.Equal(t, FormatHyphen, n.format)
+
+	n, _ = Parse("556016-0680")
+	assertReason(t, json.Unmarshal([]byte(`""`), n), ReasonLength)
+	assert.Equal(t, "5560160680", n.Format(false))
+
+	assertReason(t, json.Unmarshal([]byte(`{"number":""}`), &v), ReasonLength)
+
+	var fs flag.FlagSet
+	fs.TextVar(&v.Number, "org", &Organisationsnummer{}, "organization number")
+	assert.Nil(t, fs.Parse(nil))
+	assert.True(t, v.Number.IsZero())
+	assert.Nil(t, fs.Parse([]string{"-org", ""}))
+	assert.True(t, v.Number.IsZero())
+
+	assertReason(t, o.Scan(""), ReasonLength)
+
+	value, err := o.Value()
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	b, err := o.MarshalBinary()
+	assert.Nil(t, err)
+
+	n, _ = Parse("556016-0680")
+	assert.Nil(t, n.UnmarshalBinary(b))
+	assert.True(t, n.IsZero())
+}
+
+func TestIsZero(t *testing.T) {
+	o, _ := Parse("556016-0680")
+	assert.False(t, o.IsZero())
+
+	o, _ = Parse("19121212-1212")
+	assert.False(t, o.IsZero())
+}
+
+func TestPersonnummerOK(t *testing.T) {
+	o, _ := Parse("19121212-1212")
+	p, ok := o.PersonnummerOK()
+	assert.True(t, ok)
+	assert.Equal(t, "121212", p.Year+p.Month+p.Day)
+	assert.Equal(t, p, o.Personnummer())
+
+	o, _ = Parse("556016-0680")
+	p, ok = o.PersonnummerOK()
+	assert.False(t, ok)
+	assert.Equal(t, personnummer.Personnummer{}, p)
+	assert.Equal(t, personnummer.Personnummer{}, o.Personnummer())
+}